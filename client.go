@@ -4,12 +4,15 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math/rand"
 	"net/http"
 	"net/url"
 	"os"
+	"reflect"
 	"strconv"
 	"strings"
 	"sync"
@@ -29,6 +32,9 @@ const (
 	headerAccept      = "Accept"
 	headerScopes      = "X-OAuth-Scopes"
 	headerRetryAfter  = "Retry-After"
+	headerETag        = "ETag"
+	headerIfNoneMatch = "If-None-Match"
+	headerIfModified  = "If-Modified-Since"
 )
 
 const (
@@ -37,10 +43,18 @@ const (
 
 	// See https://docs.github.com/rest/overview/media-types
 	mediaJSON        = "application/json"
+	mediaOctetStream = "application/octet-stream"
 	mediaTypeV3      = "application/vnd.github.v3+json"
 	mediaTypeV3SHA   = "application/vnd.github.v3.sha"
 	mediaTypeV3Diff  = "application/vnd.github.v3.diff"
 	mediaTypeV3Patch = "application/vnd.github.v3.patch"
+	mediaTypeV3Raw   = "application/vnd.github.v3.raw"
+
+	// See https://docs.github.com/rest/overview/api-previews
+	mediaTypeMercyPreview       = "application/vnd.github.mercy-preview+json"
+	mediaTypeFlashPreview       = "application/vnd.github.flash-preview+json"
+	mediaTypeAntManPreview      = "application/vnd.github.ant-man-preview+json"
+	mediaTypeMockingbirdPreview = "application/vnd.github.mockingbird-preview+json"
 )
 
 // Client is used for making API calls to GitHub API v3.
@@ -54,9 +68,164 @@ type Client struct {
 	uploadURL   *url.URL
 	downloadURL *url.URL
 	accessToken string
+	authScheme  string
+	retries     int
+	jitter      float64
+	jitterMutex sync.Mutex
+	jitterRand  *rand.Rand
+	timeout     time.Duration
+	emptyOn404  bool
+	cache       Cache
+	logger      Logger
+	observer    Observer
 
 	// Services
-	Users *UsersService
+	Users         *UsersService
+	Orgs          *OrgsService
+	Search        *SearchService
+	Gists         *GistsService
+	Notifications *NotificationsService
+}
+
+// CachedResponse is a response body stored in a Cache along with its ETag.
+type CachedResponse struct {
+	ETag string
+	Body []byte
+}
+
+// Cache stores the responses of GET requests keyed by their URLs for making conditional requests.
+// Implementations must be safe for concurrent use.
+// A cache should not be shared between clients with different access tokens.
+type Cache interface {
+	Get(url string) (*CachedResponse, bool)
+	Set(url string, resp *CachedResponse)
+}
+
+// Logger is called after each HTTP call made by a client.
+// The Authorization header is removed from the request passed to the logger.
+// The response body must not be read by the logger.
+type Logger func(ctx context.Context, req *http.Request, resp *http.Response)
+
+// Observer is notified of each HTTP call made by a client, successful or not.
+// It can be used for collecting metrics such as request count and latency.
+// group is the rate limit group of the endpoint (core, search, or graphql).
+// status is zero if the call did not get a response.
+type Observer interface {
+	ObserveRequest(group, method, path string, status int, latency time.Duration)
+}
+
+const (
+	// AuthSchemeToken is the default scheme for the Authorization header.
+	AuthSchemeToken = "token"
+	// AuthSchemeBearer is the scheme documented for fine-grained personal access tokens and GitHub App tokens.
+	AuthSchemeBearer = "Bearer"
+)
+
+// Option sets an optional configuration on a client.
+type Option func(*Client)
+
+// WithRetry makes a client retry a request up to n times when it hits a rate limit.
+// On a RateLimitError, the client waits until the rate limit resets before retrying.
+// On a RateLimitAbuseError, the client waits for the duration GitHub asked for.
+// Waiting is interrupted if the request context is cancelled.
+func WithRetry(n int) Option {
+	return func(c *Client) {
+		c.retries = n
+	}
+}
+
+// WithJitter adds a random delay to the wait between retries (see WithRetry),
+// so clients sharing an access token do not all retry at the same instant when a rate limit resets.
+// The delay is chosen uniformly between zero and fraction times the wait, and it is added to the wait (additive jitter).
+// Unlike full jitter, the wait is never shortened, so a retry never happens before the rate limit resets.
+// fraction is clamped to the range [0, 1].
+// src is the source of randomness, which can be seeded for deterministic results.
+// If src is nil, a source seeded with the current time is used.
+func WithJitter(fraction float64, src rand.Source) Option {
+	return func(c *Client) {
+		if src == nil {
+			src = rand.NewSource(time.Now().UnixNano())
+		}
+
+		if fraction < 0 {
+			fraction = 0
+		} else if fraction > 1 {
+			fraction = 1
+		}
+
+		c.jitter = fraction
+		c.jitterRand = rand.New(src)
+	}
+}
+
+// WithHTTPClient makes a client use a given HTTP client for making requests.
+// This can be used for setting timeouts, proxies, or a custom transport.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		if httpClient != nil {
+			c.httpClient = httpClient
+		}
+	}
+}
+
+// WithTimeout sets a default timeout for calls made by a client.
+// The timeout only applies to requests whose context does not already have a deadline.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+// WithTreat404AsEmpty makes a client return an empty list instead of a NotFoundError
+// when a list endpoint responds with 404 Not Found.
+// Some list endpoints respond with 404 when the corresponding feature is disabled for a repository.
+// The returned Response still has the 404 status code.
+//
+// The option applies to every list call made by the client, and GitHub also responds with 404
+// when an owner or repository does not exist or is not accessible with the current token.
+// So a typo in an owner or repository name reads as no results instead of an error.
+// Endpoints that return a single object or raw content (e.g. repository traffic and contents) are not affected
+// and still return a NotFoundError.
+// To handle a 404 for specific calls only, leave this option off and check for a *NotFoundError using errors.As.
+func WithTreat404AsEmpty() Option {
+	return func(c *Client) {
+		c.emptyOn404 = true
+	}
+}
+
+// WithCache makes a client use a given cache for conditional requests.
+// When a GET request has a cached response, the client sends its ETag in the If-None-Match header.
+// If GitHub responds with 304 Not Modified, the cached body is used and the request does not count against the rate limit.
+// Responses copied to an io.Writer (e.g. downloads) are not cached.
+// Requests with the If-Modified-Since header (see IssuesParams.ModifiedSince) bypass the cache.
+// FileCache can be used for a cache that persists between runs of a program.
+func WithCache(cache Cache) Option {
+	return func(c *Client) {
+		c.cache = cache
+	}
+}
+
+// WithLogger makes a client call a given logger after each HTTP call.
+// It can be used for logging the method, path, status code, and remaining rate limit of each call.
+func WithLogger(logger Logger) Option {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+// WithObserver makes a client notify a given observer of each HTTP call.
+func WithObserver(observer Observer) Option {
+	return func(c *Client) {
+		c.observer = observer
+	}
+}
+
+// WithAuthScheme sets the scheme used in the Authorization header for the access token.
+// The default scheme is AuthSchemeToken.
+func WithAuthScheme(scheme string) Option {
+	return func(c *Client) {
+		c.authScheme = scheme
+	}
 }
 
 func newHTTPClient() *http.Client {
@@ -69,7 +238,7 @@ func newHTTPClient() *http.Client {
 }
 
 // NewClient creates a new client for calling public GitHub API v3.
-func NewClient(accessToken string) *Client {
+func NewClient(accessToken string, opts ...Option) *Client {
 	c := &Client{
 		httpClient:  newHTTPClient(),
 		rates:       map[rateGroup]Rate{},
@@ -83,11 +252,31 @@ func NewClient(accessToken string) *Client {
 		client: c,
 	}
 
+	c.Orgs = &OrgsService{
+		client: c,
+	}
+
+	c.Search = &SearchService{
+		client: c,
+	}
+
+	c.Gists = &GistsService{
+		client: c,
+	}
+
+	c.Notifications = &NotificationsService{
+		client: c,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
 	return c
 }
 
 // NewEnterpriseClient creates a new client for calling an enterprise GitHub API v3.
-func NewEnterpriseClient(apiURL, uploadURL, downloadURL, accessToken string) (*Client, error) {
+func NewEnterpriseClient(apiURL, uploadURL, downloadURL, accessToken string, opts ...Option) (*Client, error) {
 	entAPIURL, err := url.Parse(apiURL)
 	if err != nil {
 		return nil, err
@@ -116,9 +305,39 @@ func NewEnterpriseClient(apiURL, uploadURL, downloadURL, accessToken string) (*C
 		client: c,
 	}
 
+	c.Orgs = &OrgsService{
+		client: c,
+	}
+
+	c.Search = &SearchService{
+		client: c,
+	}
+
+	c.Gists = &GistsService{
+		client: c,
+	}
+
+	c.Notifications = &NotificationsService{
+		client: c,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
 	return c, nil
 }
 
+// authorization returns the value of the Authorization header for the access token.
+func (c *Client) authorization() string {
+	scheme := c.authScheme
+	if scheme == "" {
+		scheme = AuthSchemeToken
+	}
+
+	return fmt.Sprintf("%s %s", scheme, c.accessToken)
+}
+
 // NewRequest creates a new HTTP request for a GitHub API v3.
 // If body implements the io.Reader interface, the raw request body will be read.
 // Otherwise, the request body will be JOSN-encoded.
@@ -150,7 +369,7 @@ func (c *Client) NewRequest(ctx context.Context, method, url string, body interf
 	req.Header.Set(headerAccept, mediaTypeV3)
 
 	if c.accessToken != "" {
-		req.Header.Set(headerAuth, fmt.Sprintf("token %s", c.accessToken))
+		req.Header.Set(headerAuth, c.authorization())
 	}
 
 	if body != nil {
@@ -160,6 +379,21 @@ func (c *Client) NewRequest(ctx context.Context, method, url string, body interf
 	return req, nil
 }
 
+// NewRequestWithMedia creates a new HTTP request for a GitHub API v3 with a custom media type.
+// The Accept header is set to the given media type instead of the default v3 JSON media type.
+// This is needed for requesting raw content, diffs, patches, or preview APIs.
+// See https://docs.github.com/rest/overview/media-types
+func (c *Client) NewRequestWithMedia(ctx context.Context, method, url string, body interface{}, accept string) (*http.Request, error) {
+	req, err := c.NewRequest(ctx, method, url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set(headerAccept, accept)
+
+	return req, nil
+}
+
 // NewPageRequest creates a new HTTP request for a GitHub API v3 with page parameters.
 // If body implements the io.Reader interface, the raw request body will be read.
 // Otherwise, the request body will be JOSN-encoded.
@@ -181,6 +415,19 @@ func (c *Client) NewPageRequest(ctx context.Context, method, url string, pageSiz
 	return req, nil
 }
 
+// NewPageRequestWithMedia creates a new HTTP request for a GitHub API v3 with page parameters and a custom media type.
+// This is needed for listing preview APIs page by page.
+func (c *Client) NewPageRequestWithMedia(ctx context.Context, method, url string, pageSize, pageNo int, body interface{}, accept string) (*http.Request, error) {
+	req, err := c.NewPageRequest(ctx, method, url, pageSize, pageNo, body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set(headerAccept, accept)
+
+	return req, nil
+}
+
 // NewUploadRequest creates a new HTTP request for uploading a file to a GitHub release.
 // When successful, it returns a closer for the given file that should be closed after making the request.
 func (c *Client) NewUploadRequest(ctx context.Context, url, filepath string) (*http.Request, io.Closer, error) {
@@ -228,7 +475,7 @@ func (c *Client) NewUploadRequest(ctx context.Context, url, filepath string) (*h
 	req.Header.Set(headerContentType, mediaType)
 
 	if c.accessToken != "" {
-		req.Header.Set(headerAuth, fmt.Sprintf("token %s", c.accessToken))
+		req.Header.Set(headerAuth, c.authorization())
 	}
 
 	return req, f, nil
@@ -249,7 +496,7 @@ func (c *Client) NewDownloadRequest(ctx context.Context, url string) (*http.Requ
 	req.Header.Set(headerUserAgent, userAgent)
 
 	if c.accessToken != "" {
-		req.Header.Set(headerAuth, fmt.Sprintf("token %s", c.accessToken))
+		req.Header.Set(headerAuth, c.authorization())
 	}
 
 	return req, nil
@@ -258,7 +505,99 @@ func (c *Client) NewDownloadRequest(ctx context.Context, url string) (*http.Requ
 // Do makes an HTTP request and returns the API response.
 // If body implements the io.Writer interface, the raw response body will be copied to.
 // Otherwise, the response body will be JOSN-decoded into it.
+// If the client is created with WithRetry, rate-limited requests are retried.
+// If the client is created with WithTimeout, the timeout applies to the whole call including the retries.
 func (c *Client) Do(req *http.Request, body interface{}) (*Response, error) {
+	if c.timeout > 0 {
+		if _, ok := req.Context().Deadline(); !ok {
+			// The response body is fully read before returning, so the context can be cancelled then.
+			ctx, cancel := context.WithTimeout(req.Context(), c.timeout)
+			defer cancel()
+			req = req.WithContext(ctx)
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := c.do(req, body)
+		if err == nil || attempt >= c.retries {
+			return resp, err
+		}
+
+		var wait time.Duration
+		var rateErr *RateLimitError
+		var abuseErr *RateLimitAbuseError
+
+		if errors.As(err, &rateErr) {
+			wait = time.Until(rateErr.Rate.Reset.Time())
+		} else if errors.As(err, &abuseErr) {
+			wait = abuseErr.RetryAfter
+		} else {
+			return nil, err
+		}
+
+		// The request body has been consumed by the previous attempt
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return nil, err
+			}
+
+			if req.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := sleep(req.Context(), c.jitterWait(wait)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// jitterWait adds a random delay of up to the jitter fraction of a given wait to the wait.
+func (c *Client) jitterWait(wait time.Duration) time.Duration {
+	if c.jitter <= 0 || c.jitterRand == nil || wait <= 0 {
+		return wait
+	}
+
+	// rand.Rand is not safe for concurrent use
+	c.jitterMutex.Lock()
+	r := c.jitterRand.Float64()
+	c.jitterMutex.Unlock()
+
+	return wait + time.Duration(r*c.jitter*float64(wait))
+}
+
+// setEmptySlice sets the value of v to an empty slice if v is a pointer to a slice.
+// It reports whether or not v is a pointer to a slice.
+// Byte slices (e.g. json.RawMessage) hold a raw response rather than a list, so they are not considered slices.
+func setEmptySlice(v interface{}) bool {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Slice {
+		return false
+	}
+
+	if rv.Elem().Type().Elem().Kind() == reflect.Uint8 {
+		return false
+	}
+
+	rv.Elem().Set(reflect.MakeSlice(rv.Elem().Type(), 0, 0))
+
+	return true
+}
+
+// sleep waits for a given duration or until the context is cancelled.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (c *Client) do(req *http.Request, body interface{}) (*Response, error) {
 	// ====================> CHECK RATE LIMITS <====================
 
 	g := getRateGroup(req.URL)
@@ -267,20 +606,53 @@ func (c *Client) Do(req *http.Request, body interface{}) (*Response, error) {
 	rate, ok := c.rates[g]
 	c.ratesMutex.Unlock()
 
-	if ok && rate.Remaining == 0 && time.Now().Before(rate.Reset.Time()) {
+	// The rate limit endpoint does not count against the rate limit
+	// The path is checked by suffix, since Enterprise API URLs have a prefix (e.g. /api/v3/rate_limit).
+	if ok && rate.Remaining == 0 && time.Now().Before(rate.Reset.Time()) && !strings.HasSuffix(req.URL.Path, "/rate_limit") {
 		return nil, &RateLimitError{
 			Request: req,
 			Rate:    rate,
 		}
 	}
 
+	// ====================> CHECK THE CACHE <====================
+
+	// Responses copied to an io.Writer are streamed (e.g. archives), so they are never buffered for the cache.
+	// Requests with the If-Modified-Since header are conditional on their own, so a 304 is reported as ErrNotModified.
+	_, isWriter := body.(io.Writer)
+	useCache := c.cache != nil && req.Method == "GET" && !isWriter && req.Header.Get(headerIfModified) == ""
+
+	var cached *CachedResponse
+	if useCache {
+		if cr, ok := c.cache.Get(req.URL.String()); ok {
+			cached = cr
+			req.Header.Set(headerIfNoneMatch, cached.ETag)
+		}
+	}
+
 	// ====================> MAKE THE REQUEST <====================
 
+	start := time.Now()
 	r, err := c.httpClient.Do(req)
+
+	if c.observer != nil {
+		var status int
+		if r != nil {
+			status = r.StatusCode
+		}
+		c.observer.ObserveRequest(string(g), req.Method, req.URL.Path, status, time.Since(start))
+	}
+
 	if err != nil {
 		return nil, err
 	}
 
+	if c.logger != nil {
+		logReq := req.Clone(req.Context())
+		logReq.Header.Del(headerAuth)
+		c.logger(req.Context(), logReq, r)
+	}
+
 	defer func() {
 		// Ensure we fully read and close the response body, so the underlying TCP connection can be reused.
 		// If it errors, the TCP connection will not be reused anyway.
@@ -291,16 +663,40 @@ func (c *Client) Do(req *http.Request, body interface{}) (*Response, error) {
 	resp := newResponse(r)
 
 	// Update rate limits
-	c.ratesMutex.Lock()
-	c.rates[g] = resp.Rate
-	c.ratesMutex.Unlock()
+	// Responses without the rate limit headers (e.g. downloads and redirects to other hosts) do not change the rate.
+	if resp.Rate.Limit > 0 {
+		c.ratesMutex.Lock()
+		c.rates[g] = resp.Rate
+		c.ratesMutex.Unlock()
+	}
 
 	// ====================> CHECK THE RESPONSE <====================
 
+	var respBody io.Reader = r.Body
+
+	if r.StatusCode == http.StatusNotModified && cached != nil {
+		respBody = bytes.NewReader(cached.Body)
+	} else if useCache && r.StatusCode == http.StatusOK && r.Header.Get(headerETag) != "" {
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		c.cache.Set(req.URL.String(), &CachedResponse{
+			ETag: r.Header.Get(headerETag),
+			Body: b,
+		})
+
+		respBody = bytes.NewReader(b)
+	}
+
 	isSuccess := func(statusCode int) bool {
 		return statusCode == http.StatusOK ||
 			statusCode == http.StatusCreated ||
-			statusCode == http.StatusNoContent
+			statusCode == http.StatusAccepted ||
+			statusCode == http.StatusNoContent ||
+			statusCode == http.StatusResetContent ||
+			(statusCode == http.StatusNotModified && cached != nil)
 	}
 
 	if !isSuccess(r.StatusCode) {
@@ -310,13 +706,14 @@ func (c *Client) Do(req *http.Request, body interface{}) (*Response, error) {
 
 		b, err := ioutil.ReadAll(resp.Body)
 		if err == nil && b != nil {
+			respErr.Body = b
 			_ = json.Unmarshal(b, respErr)
 		}
 
-		// Restore response body
-		// r.Body = ioutil.NopCloser(bytes.NewBuffer(b))
-
 		switch r.StatusCode {
+		case http.StatusNotModified:
+			return nil, ErrNotModified
+
 		case http.StatusBadRequest:
 			return nil, respErr
 
@@ -332,7 +729,9 @@ func (c *Client) Do(req *http.Request, body interface{}) (*Response, error) {
 					Request: req,
 					Rate:    resp.Rate,
 				}
-			} else if strings.HasSuffix(respErr.DocumentationURL, "#abuse-rate-limits") {
+			} else if r.Header.Get(headerRetryAfter) != "" || strings.HasSuffix(respErr.DocumentationURL, "#abuse-rate-limits") {
+				// Secondary rate limits are identified by the Retry-After header.
+				// The documentation URL is checked as well for responses that do not include the header.
 				retryAfter, _ := time.ParseDuration(r.Header.Get(headerRetryAfter) + "s")
 				return nil, &RateLimitAbuseError{
 					err:        respErr,
@@ -341,11 +740,34 @@ func (c *Client) Do(req *http.Request, body interface{}) (*Response, error) {
 				}
 			}
 
+		case http.StatusTooManyRequests:
+			retryAfter, _ := time.ParseDuration(r.Header.Get(headerRetryAfter) + "s")
+			return nil, &RateLimitAbuseError{
+				err:        respErr,
+				Rate:       resp.Rate,
+				RetryAfter: retryAfter,
+			}
+
 		case http.StatusNotFound:
+			if c.emptyOn404 && setEmptySlice(body) {
+				return resp, nil
+			}
+
 			return nil, &NotFoundError{
 				err: respErr,
 			}
 
+		case http.StatusConflict:
+			return nil, &ConflictError{
+				err: respErr,
+			}
+
+		case http.StatusUnprocessableEntity:
+			return nil, &ValidationError{
+				err:    respErr,
+				Errors: respErr.Errors,
+			}
+
 		default:
 			return nil, respErr
 		}
@@ -355,11 +777,11 @@ func (c *Client) Do(req *http.Request, body interface{}) (*Response, error) {
 
 	if body != nil {
 		if w, ok := body.(io.Writer); ok {
-			if _, err := io.Copy(w, r.Body); err != nil {
+			if _, err := io.Copy(w, respBody); err != nil {
 				return nil, err
 			}
 		} else {
-			if err := json.NewDecoder(r.Body).Decode(body); err != nil && err != io.EOF {
+			if err := json.NewDecoder(respBody).Decode(body); err != nil && err != io.EOF {
 				return nil, err
 			}
 		}
@@ -393,6 +815,82 @@ func (c *Client) EnsureScopes(ctx context.Context, scopes ...Scope) error {
 	return nil
 }
 
+// RateLimits retrieves the current rate limit status for all rate limit groups (core, search, graphql, etc.).
+// Calling this endpoint does not count against the rate limit.
+// See https://docs.github.com/rest/reference/rate-limit#get-rate-limit-status-for-the-authenticated-user
+func (c *Client) RateLimits(ctx context.Context) (map[string]Rate, *Response, error) {
+	req, err := c.NewRequest(ctx, "GET", "/rate_limit", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body := new(struct {
+		Resources map[string]Rate `json:"resources"`
+	})
+
+	resp, err := c.Do(req, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rates := body.Resources
+	if rates == nil {
+		rates = map[string]Rate{}
+	}
+
+	return rates, resp, nil
+}
+
+// Rates returns a copy of the latest rate limit status cached by the client for each rate limit group.
+// The rates are updated after every call, so reading them does not require a request.
+// The map is keyed by the group name (core, search, or graphql).
+func (c *Client) Rates() map[string]Rate {
+	c.ratesMutex.Lock()
+	defer c.ratesMutex.Unlock()
+
+	rates := make(map[string]Rate, len(c.rates))
+	for g, rate := range c.rates {
+		rates[string(g)] = rate
+	}
+
+	return rates
+}
+
+// NextReset returns the earliest reset time among the rate groups cached by the client.
+// Groups with remaining calls are preferred; if all groups are exhausted, the soonest reset among them is returned.
+// Rates without a limit or with a reset time in the past are ignored.
+// It returns the zero time if no such rate has been cached.
+func (c *Client) NextReset() time.Time {
+	c.ratesMutex.Lock()
+	defer c.ratesMutex.Unlock()
+
+	now := time.Now()
+
+	var available, exhausted time.Time
+	for _, rate := range c.rates {
+		t := rate.Reset.Time()
+		if rate.Limit == 0 || !t.After(now) {
+			continue
+		}
+
+		if rate.Remaining > 0 {
+			if available.IsZero() || t.Before(available) {
+				available = t
+			}
+		} else {
+			if exhausted.IsZero() || t.Before(exhausted) {
+				exhausted = t
+			}
+		}
+	}
+
+	if !available.IsZero() {
+		return available
+	}
+
+	return exhausted
+}
+
 // Repo returns a service providing GitHub APIs for a specific repository.
 func (c *Client) Repo(owner, repo string) *RepoService {
 	return &RepoService{
@@ -3,8 +3,15 @@ package github
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math/rand"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -36,20 +43,160 @@ var (
 	}
 )
 
+func TestWithRetry(t *testing.T) {
+	c := new(Client)
+	WithRetry(3)(c)
+
+	assert.Equal(t, 3, c.retries)
+}
+
+func TestWithJitter(t *testing.T) {
+	t.Run("NilSource", func(t *testing.T) {
+		c := new(Client)
+		WithJitter(0.5, nil)(c)
+
+		assert.Equal(t, 0.5, c.jitter)
+		assert.NotNil(t, c.jitterRand)
+	})
+
+	t.Run("Source", func(t *testing.T) {
+		c := new(Client)
+		WithJitter(0.5, rand.NewSource(1))(c)
+
+		assert.Equal(t, 0.5, c.jitter)
+		assert.NotNil(t, c.jitterRand)
+	})
+
+	t.Run("NegativeFraction", func(t *testing.T) {
+		c := new(Client)
+		WithJitter(-0.5, rand.NewSource(1))(c)
+
+		assert.Equal(t, 0.0, c.jitter)
+		assert.Equal(t, time.Minute, c.jitterWait(time.Minute))
+	})
+
+	t.Run("LargeFraction", func(t *testing.T) {
+		c := new(Client)
+		WithJitter(5, rand.NewSource(1))(c)
+
+		assert.Equal(t, 1.0, c.jitter)
+		for i := 0; i < 100; i++ {
+			wait := c.jitterWait(time.Minute)
+			assert.True(t, wait >= time.Minute && wait <= 2*time.Minute)
+		}
+	})
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestWithTimeout(t *testing.T) {
+	c := new(Client)
+	WithTimeout(10 * time.Second)(c)
+
+	assert.Equal(t, 10*time.Second, c.timeout)
+}
+
+func TestWithTreat404AsEmpty(t *testing.T) {
+	c := new(Client)
+	WithTreat404AsEmpty()(c)
+
+	assert.True(t, c.emptyOn404)
+}
+
+func TestWithCache(t *testing.T) {
+	cache := mapCache{}
+
+	c := new(Client)
+	WithCache(cache)(c)
+
+	assert.Equal(t, cache, c.cache)
+}
+
+func TestWithLogger(t *testing.T) {
+	var called bool
+	logger := func(context.Context, *http.Request, *http.Response) {
+		called = true
+	}
+
+	c := new(Client)
+	WithLogger(logger)(c)
+	c.logger(context.Background(), nil, nil)
+
+	assert.True(t, called)
+}
+
+func TestWithObserver(t *testing.T) {
+	observer := new(mockObserver)
+
+	c := new(Client)
+	WithObserver(observer)(c)
+
+	assert.Equal(t, observer, c.observer)
+}
+
+func TestWithAuthScheme(t *testing.T) {
+	c := new(Client)
+	WithAuthScheme(AuthSchemeBearer)(c)
+
+	assert.Equal(t, AuthSchemeBearer, c.authScheme)
+}
+
+func TestWithHTTPClient(t *testing.T) {
+	httpClient := &http.Client{}
+
+	tests := []struct {
+		name               string
+		httpClient         *http.Client
+		expectedHTTPClient *http.Client
+	}{
+		{
+			name:               "Nil",
+			httpClient:         nil,
+			expectedHTTPClient: nil,
+		},
+		{
+			name:               "OK",
+			httpClient:         httpClient,
+			expectedHTTPClient: httpClient,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := new(Client)
+			WithHTTPClient(tc.httpClient)(c)
+
+			assert.Equal(t, tc.expectedHTTPClient, c.httpClient)
+		})
+	}
+}
+
 func TestNewClient(t *testing.T) {
 	tests := []struct {
-		name        string
-		accessToken string
+		name            string
+		accessToken     string
+		opts            []Option
+		expectedRetries int
 	}{
 		{
 			name:        "OK",
 			accessToken: "access-token",
 		},
+		{
+			name:            "WithOptions",
+			accessToken:     "access-token",
+			opts:            []Option{WithRetry(2), WithHTTPClient(&http.Client{})},
+			expectedRetries: 2,
+		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			c := NewClient(tc.accessToken)
+			c := NewClient(tc.accessToken, tc.opts...)
 
 			assert.NotNil(t, c)
 			assert.NotNil(t, c.httpClient)
@@ -58,19 +205,26 @@ func TestNewClient(t *testing.T) {
 			assert.NotNil(t, c.uploadURL)
 			assert.NotNil(t, c.downloadURL)
 			assert.Equal(t, tc.accessToken, c.accessToken)
+			assert.Equal(t, tc.expectedRetries, c.retries)
 			assert.NotNil(t, c.Users)
+			assert.NotNil(t, c.Orgs)
+			assert.NotNil(t, c.Search)
+			assert.NotNil(t, c.Gists)
+			assert.NotNil(t, c.Notifications)
 		})
 	}
 }
 
 func TestNewEnterpriseClient(t *testing.T) {
 	tests := []struct {
-		name          string
-		apiURL        string
-		uploadURL     string
-		downloadURL   string
-		accessToken   string
-		expectedError string
+		name            string
+		apiURL          string
+		uploadURL       string
+		downloadURL     string
+		accessToken     string
+		opts            []Option
+		expectedRetries int
+		expectedError   string
 	}{
 		{
 			name:          "InvalidAPIURL",
@@ -102,11 +256,20 @@ func TestNewEnterpriseClient(t *testing.T) {
 			accessToken:   "access-token",
 			expectedError: ``,
 		},
+		{
+			name:            "WithOptions",
+			apiURL:          "https://api.github.internal.com",
+			uploadURL:       "https://uploads.github.internal.com",
+			downloadURL:     "https://github.internal.com",
+			accessToken:     "access-token",
+			opts:            []Option{WithRetry(2)},
+			expectedRetries: 2,
+		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			c, err := NewEnterpriseClient(tc.apiURL, tc.uploadURL, tc.downloadURL, tc.accessToken)
+			c, err := NewEnterpriseClient(tc.apiURL, tc.uploadURL, tc.downloadURL, tc.accessToken, tc.opts...)
 
 			if tc.expectedError != "" {
 				assert.Nil(t, c)
@@ -120,7 +283,12 @@ func TestNewEnterpriseClient(t *testing.T) {
 				assert.NotNil(t, c.uploadURL)
 				assert.NotNil(t, c.downloadURL)
 				assert.Equal(t, tc.accessToken, c.accessToken)
+				assert.Equal(t, tc.expectedRetries, c.retries)
 				assert.NotNil(t, c.Users)
+				assert.NotNil(t, c.Orgs)
+				assert.NotNil(t, c.Search)
+				assert.NotNil(t, c.Gists)
+				assert.NotNil(t, c.Notifications)
 			}
 		})
 	}
@@ -208,6 +376,117 @@ func TestClient_NewRequest(t *testing.T) {
 	}
 }
 
+func TestClient_NewRequestWithMedia(t *testing.T) {
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		method         string
+		url            string
+		body           interface{}
+		accept         string
+		expectedAccept string
+		expectedError  string
+	}{
+		{
+			name:          "NilContext",
+			ctx:           nil,
+			method:        "GET",
+			url:           "/repos/octocat/Hello-World/commits/main",
+			body:          nil,
+			accept:        mediaTypeV3Diff,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name:           "Diff",
+			ctx:            context.Background(),
+			method:         "GET",
+			url:            "/repos/octocat/Hello-World/commits/main",
+			body:           nil,
+			accept:         mediaTypeV3Diff,
+			expectedAccept: "application/vnd.github.v3.diff",
+		},
+		{
+			name:           "Preview",
+			ctx:            context.Background(),
+			method:         "PUT",
+			url:            "/repos/octocat/Hello-World/topics",
+			body:           new(struct{}),
+			accept:         mediaTypeMercyPreview,
+			expectedAccept: "application/vnd.github.mercy-preview+json",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := &Client{
+				apiURL:      publicAPIURL,
+				accessToken: "access-token",
+			}
+
+			req, err := c.NewRequestWithMedia(tc.ctx, tc.method, tc.url, tc.body, tc.accept)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, req)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, req)
+				assert.Equal(t, tc.expectedAccept, req.Header.Get(headerAccept))
+				assert.NotEmpty(t, req.Header.Get(headerAuth))
+			}
+		})
+	}
+}
+
+func TestClient_AuthScheme(t *testing.T) {
+	tests := []struct {
+		name          string
+		authScheme    string
+		expectedValue string
+	}{
+		{
+			name:          "Default",
+			authScheme:    "",
+			expectedValue: "token access-token",
+		},
+		{
+			name:          "Token",
+			authScheme:    AuthSchemeToken,
+			expectedValue: "token access-token",
+		},
+		{
+			name:          "Bearer",
+			authScheme:    AuthSchemeBearer,
+			expectedValue: "Bearer access-token",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := &Client{
+				apiURL:      publicAPIURL,
+				uploadURL:   publicUploadURL,
+				downloadURL: publicDownloadURL,
+				accessToken: "access-token",
+				authScheme:  tc.authScheme,
+			}
+
+			req, err := c.NewRequest(context.Background(), "GET", "/user", nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedValue, req.Header.Get(headerAuth))
+
+			req, closer, err := c.NewUploadRequest(context.Background(), "/repos/octocat/Hello-World/releases/1/assets", "test/asset")
+			assert.NoError(t, err)
+			defer closer.Close()
+			assert.Equal(t, tc.expectedValue, req.Header.Get(headerAuth))
+
+			req, err = c.NewDownloadRequest(context.Background(), "/octocat/Hello-World/archive/main.zip")
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedValue, req.Header.Get(headerAuth))
+		})
+	}
+}
+
 func TestClient_NewPageRequest(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -286,6 +565,66 @@ func TestClient_NewPageRequest(t *testing.T) {
 	}
 }
 
+func TestClient_NewPageRequestWithMedia(t *testing.T) {
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		method         string
+		url            string
+		pageSize       int
+		pageNo         int
+		body           interface{}
+		accept         string
+		expectedAccept string
+		expectedError  string
+	}{
+		{
+			name:          "NilContext",
+			ctx:           nil,
+			method:        "GET",
+			url:           "/repos/octocat/Hello-World/issues/1347/timeline",
+			pageSize:      20,
+			pageNo:        2,
+			body:          nil,
+			accept:        mediaTypeMockingbirdPreview,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name:           "Preview",
+			ctx:            context.Background(),
+			method:         "GET",
+			url:            "/repos/octocat/Hello-World/issues/1347/timeline",
+			pageSize:       20,
+			pageNo:         2,
+			body:           nil,
+			accept:         mediaTypeMockingbirdPreview,
+			expectedAccept: "application/vnd.github.mockingbird-preview+json",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := &Client{
+				apiURL:      publicAPIURL,
+				accessToken: "access-token",
+			}
+
+			req, err := c.NewPageRequestWithMedia(tc.ctx, tc.method, tc.url, tc.pageSize, tc.pageNo, tc.body, tc.accept)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, req)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, req)
+				assert.Equal(t, tc.expectedAccept, req.Header.Get(headerAccept))
+				assert.Equal(t, "20", req.URL.Query().Get("per_page"))
+				assert.Equal(t, "2", req.URL.Query().Get("page"))
+			}
+		})
+	}
+}
+
 func TestClient_NewUploadRequest(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -420,14 +759,16 @@ func TestClient_Do(t *testing.T) {
 	reset := time.Now().Add(time.Hour)
 
 	tests := []struct {
-		name             string
-		mockResponses    []MockResponse
-		c                *Client
-		reqMethod        string
-		reqURL           string
-		body             interface{}
-		expectedResponse *Response
-		expectedError    string
+		name              string
+		mockResponses     []MockResponse
+		c                 *Client
+		reqMethod         string
+		reqURL            string
+		body              interface{}
+		expectedBody      interface{}
+		expectedResponse  *Response
+		expectedError     string
+		expectedErrorType error
 	}{
 		{
 			name:          "NoRemainingRateLimit",
@@ -535,10 +876,35 @@ func TestClient_Do(t *testing.T) {
 				httpClient: &http.Client{},
 				rates:      map[rateGroup]Rate{},
 			},
-			reqMethod:     "GET",
-			reqURL:        "/user",
-			body:          nil,
-			expectedError: `GET /user: 403 You have triggered an abuse detection mechanism`,
+			reqMethod:         "GET",
+			reqURL:            "/user",
+			body:              nil,
+			expectedError:     `GET /user: 403 You have triggered an abuse detection mechanism`,
+			expectedErrorType: &RateLimitAbuseError{},
+		},
+		{
+			name: "SecondaryRateLimitError",
+			mockResponses: []MockResponse{
+				{
+					"GET", "/user", 403,
+					http.Header{
+						headerRetryAfter: {"60"},
+					},
+					`{
+						"message": "You have exceeded a secondary rate limit. Please wait a few minutes before you try again.",
+						"documentation_url": "https://docs.github.com/rest/overview/resources-in-the-rest-api#secondary-rate-limits"
+					}`,
+				},
+			},
+			c: &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+			},
+			reqMethod:         "GET",
+			reqURL:            "/user",
+			body:              nil,
+			expectedError:     `GET /user: 403 You have exceeded a secondary rate limit. Please wait a few minutes before you try again.`,
+			expectedErrorType: &RateLimitAbuseError{},
 		},
 		{
 			name: "NotFoundError",
@@ -552,10 +918,68 @@ func TestClient_Do(t *testing.T) {
 				httpClient: &http.Client{},
 				rates:      map[rateGroup]Rate{},
 			},
+			reqMethod:         "GET",
+			reqURL:            "/users/octocat",
+			body:              nil,
+			expectedError:     `GET /users/octocat: 404 Not Found`,
+			expectedErrorType: &NotFoundError{},
+		},
+		{
+			name: "NotModified",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/issues", 304, header, ``},
+			},
+			c: &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+			},
 			reqMethod:     "GET",
-			reqURL:        "/users/octocat",
+			reqURL:        "/repos/octocat/Hello-World/issues",
 			body:          nil,
-			expectedError: `GET /users/octocat: 404 Not Found`,
+			expectedError: `not modified`,
+		},
+		{
+			name: "ConflictError",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/pulls/1002/merge", 409, http.Header{}, `{
+					"message": "Head branch was modified. Review and try the merge again.",
+					"documentation_url": "https://docs.github.com/rest/reference/pulls#merge-a-pull-request"
+				}`},
+			},
+			c: &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+			},
+			reqMethod:         "PUT",
+			reqURL:            "/repos/octocat/Hello-World/pulls/1002/merge",
+			body:              nil,
+			expectedError:     `PUT /repos/octocat/Hello-World/pulls/1002/merge: 409 Head branch was modified. Review and try the merge again.`,
+			expectedErrorType: &ConflictError{},
+		},
+		{
+			name: "ValidationError",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/pulls", 422, http.Header{}, `{
+					"message": "Validation Failed",
+					"errors": [
+						{
+							"resource": "PullRequest",
+							"field": "base",
+							"code": "invalid"
+						}
+					],
+					"documentation_url": "https://docs.github.com/rest/reference/pulls#create-a-pull-request"
+				}`},
+			},
+			c: &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+			},
+			reqMethod:         "POST",
+			reqURL:            "/repos/octocat/Hello-World/pulls",
+			body:              nil,
+			expectedError:     `POST /repos/octocat/Hello-World/pulls: 422 Validation Failed (PullRequest.base invalid)`,
+			expectedErrorType: &ValidationError{},
 		},
 		{
 			name: "StatusInternalServerError",
@@ -651,6 +1075,34 @@ func TestClient_Do(t *testing.T) {
 				Rate:  expectedRate,
 			},
 		},
+		{
+			name: "Success_Accepted",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/forks", 202, header, `{
+						"login": "octocat",
+						"id": 1,
+						"name": "The Octocat",
+						"email": "octocat@github.com"
+				}`},
+			},
+			c: &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+			},
+			reqMethod: "POST",
+			reqURL:    "/repos/octocat/Hello-World/forks",
+			body:      new(user),
+			expectedBody: &user{
+				ID:    1,
+				Login: "octocat",
+				Email: "octocat@github.com",
+				Name:  "The Octocat",
+			},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -674,35 +1126,749 @@ func TestClient_Do(t *testing.T) {
 			if tc.expectedError != "" {
 				assert.Nil(t, resp)
 				assert.EqualError(t, err, tc.expectedError)
+
+				if tc.expectedErrorType != nil {
+					assert.IsType(t, tc.expectedErrorType, err)
+				}
 			} else {
 				assert.NoError(t, err)
 				assert.NotNil(t, resp)
 				assert.NotNil(t, resp.Response)
 				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
 				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+
+				if tc.expectedBody != nil {
+					assert.Equal(t, tc.expectedBody, tc.body)
+				}
 			}
 		})
 	}
 }
 
-func TestClient_EnsureScopes(t *testing.T) {
+func TestClient_Do_ResponseErrorBody(t *testing.T) {
+	body := `{
+		"message": "Validation Failed",
+		"errors": [
+			{
+				"resource": "Issue",
+				"field": "title",
+				"code": "missing_field"
+			}
+		],
+		"documentation_url": "https://docs.github.com/rest/reference/issues#create-an-issue"
+	}`
+
+	ts := newHTTPTestServer(MockResponse{"POST", "/repos/octocat/Hello-World/issues", 422, http.Header{}, body})
+	defer ts.Close()
+
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+	}
+	c.apiURL, _ = url.Parse(ts.URL)
+
+	req, err := c.NewRequest(context.Background(), "POST", "/repos/octocat/Hello-World/issues", nil)
+	assert.NoError(t, err)
+
+	resp, err := c.Do(req, nil)
+	assert.Nil(t, resp)
+	assert.EqualError(t, err, `POST /repos/octocat/Hello-World/issues: 422 Validation Failed (Issue.title missing_field)`)
+
+	var validationErr *ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, []ResponseErrorDetail{
+		{Resource: "Issue", Field: "title", Code: "missing_field"},
+	}, validationErr.Errors)
+
+	var respErr *ResponseError
+	assert.True(t, errors.As(err, &respErr))
+	assert.Equal(t, body, string(respErr.Body))
+	assert.Equal(t, []ResponseErrorDetail{
+		{Resource: "Issue", Field: "title", Code: "missing_field"},
+	}, respErr.Errors)
+	assert.Equal(t, "https://docs.github.com/rest/reference/issues#create-an-issue", respErr.DocumentationURL)
+}
+
+func TestClient_Do_TooManyRequests(t *testing.T) {
+	ts := newHTTPTestServer(MockResponse{"GET", "/user", 429, http.Header{headerRetryAfter: {"60"}}, `{
+		"message": "You have exceeded a secondary rate limit. Please wait a few minutes before you try again.",
+		"documentation_url": "https://docs.github.com/rest/overview/resources-in-the-rest-api#secondary-rate-limits"
+	}`})
+	defer ts.Close()
+
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+	}
+	c.apiURL, _ = url.Parse(ts.URL)
+
+	req, err := c.NewRequest(context.Background(), "GET", "/user", nil)
+	assert.NoError(t, err)
+
+	resp, err := c.Do(req, nil)
+	assert.Nil(t, resp)
+	assert.EqualError(t, err, `GET /user: 429 You have exceeded a secondary rate limit. Please wait a few minutes before you try again.`)
+
+	var abuseErr *RateLimitAbuseError
+	assert.True(t, errors.As(err, &abuseErr))
+	assert.Equal(t, 60*time.Second, abuseErr.RetryAfter)
+}
+
+func TestClient_Do_RateLimitEndpoint(t *testing.T) {
 	tests := []struct {
 		name          string
-		mockResponses []MockResponse
-		ctx           context.Context
-		scopes        []Scope
+		mockResponse  MockResponse
+		apiPath       string
+		url           string
 		expectedError string
 	}{
 		{
-			name:          "NilContext",
-			mockResponses: []MockResponse{},
-			ctx:           nil,
-			scopes:        []Scope{ScopeRepo},
-			expectedError: `net/http: nil Context`,
+			name:         "Public",
+			mockResponse: MockResponse{"GET", "/rate_limit", 200, header, `{}`},
+			apiPath:      "/",
+			url:          "/rate_limit",
 		},
 		{
-			name: "InvalidStatusCode",
-			mockResponses: []MockResponse{
+			name:         "Enterprise",
+			mockResponse: MockResponse{"GET", "/api/v3/rate_limit", 200, header, `{}`},
+			apiPath:      "/api/v3/",
+			url:          "rate_limit",
+		},
+		{
+			name:          "EnterpriseOther",
+			mockResponse:  MockResponse{"GET", "/api/v3/user", 200, header, `{}`},
+			apiPath:       "/api/v3/",
+			url:           "user",
+			expectedError: `GET /api/v3/user: rate limit 5000 used`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponse)
+			defer ts.Close()
+
+			c := &Client{
+				httpClient: &http.Client{},
+				rates: map[rateGroup]Rate{
+					rateGroupCore: {
+						Limit:     5000,
+						Used:      5000,
+						Remaining: 0,
+						Reset:     Epoch(time.Now().Add(time.Hour).Unix()),
+					},
+				},
+			}
+			c.apiURL, _ = url.Parse(ts.URL + tc.apiPath)
+
+			req, err := c.NewRequest(context.Background(), "GET", tc.url, nil)
+			assert.NoError(t, err)
+
+			resp, err := c.Do(req, nil)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedError)
+				var rateLimitErr *RateLimitError
+				assert.True(t, errors.As(err, &rateLimitErr))
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+			}
+		})
+	}
+}
+
+func TestClient_Do_Retry(t *testing.T) {
+	type response struct {
+		statusCode int
+		header     http.Header
+		body       string
+	}
+
+	rateLimited := response{
+		statusCode: 403,
+		header: http.Header{
+			headerRateLimit:     {"5000"},
+			headerRateUsed:      {"5000"},
+			headerRateRemaining: {"0"},
+			headerRateReset:     {strconv.FormatInt(time.Now().Unix(), 10)},
+		},
+		body: `{
+			"message": "API rate limit exceeded",
+			"documentation_url": "https://docs.github.com/rest/overview/resources-in-the-rest-api#rate-limiting"
+		}`,
+	}
+
+	rateLimitedUntilLater := response{
+		statusCode: 403,
+		header: http.Header{
+			headerRateLimit:     {"5000"},
+			headerRateUsed:      {"5000"},
+			headerRateRemaining: {"0"},
+			headerRateReset:     {strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10)},
+		},
+		body: `{
+			"message": "API rate limit exceeded",
+			"documentation_url": "https://docs.github.com/rest/overview/resources-in-the-rest-api#rate-limiting"
+		}`,
+	}
+
+	abused := response{
+		statusCode: 403,
+		header: http.Header{
+			headerRetryAfter: {"0"},
+		},
+		body: `{
+			"message": "You have triggered an abuse detection mechanism",
+			"documentation_url": "https://docs.github.com/rest/overview/resources-in-the-rest-api#abuse-rate-limits"
+		}`,
+	}
+
+	success := response{
+		statusCode: 200,
+		header:     header,
+		body:       `{"login": "octocat"}`,
+	}
+
+	shortCtx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	tests := []struct {
+		name          string
+		retries       int
+		ctx           context.Context
+		responses     []response
+		expectedCalls int
+		expectedLogin string
+		expectedError string
+	}{
+		{
+			name:          "NoRetry",
+			retries:       0,
+			ctx:           context.Background(),
+			responses:     []response{rateLimited, success},
+			expectedCalls: 1,
+			expectedError: `POST /user: rate limit 5000 used`,
+		},
+		{
+			name:          "RetriesExhausted",
+			retries:       2,
+			ctx:           context.Background(),
+			responses:     []response{rateLimited, rateLimited, rateLimited, success},
+			expectedCalls: 3,
+			expectedError: `POST /user: rate limit 5000 used`,
+		},
+		{
+			name:          "ContextCancelled",
+			retries:       2,
+			ctx:           shortCtx,
+			responses:     []response{rateLimitedUntilLater, success},
+			expectedCalls: 1,
+			expectedError: `context deadline exceeded`,
+		},
+		{
+			name:          "NonRateLimitError",
+			retries:       2,
+			ctx:           context.Background(),
+			responses:     []response{{statusCode: 401, header: http.Header{}, body: `{"message": "Bad credentials"}`}, success},
+			expectedCalls: 1,
+			expectedError: `POST /user: 401 Bad credentials`,
+		},
+		{
+			name:          "Success_RateLimit",
+			retries:       2,
+			ctx:           context.Background(),
+			responses:     []response{rateLimited, success},
+			expectedCalls: 2,
+			expectedLogin: "octocat",
+		},
+		{
+			name:          "Success_RateLimitAbuse",
+			retries:       2,
+			ctx:           context.Background(),
+			responses:     []response{abused, success},
+			expectedCalls: 2,
+			expectedLogin: "octocat",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls int
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// Make sure the request body is sent again on each attempt
+				b, _ := ioutil.ReadAll(r.Body)
+				assert.JSONEq(t, `{"name": "The Octocat"}`, string(b))
+
+				resp := tc.responses[calls]
+				calls++
+
+				for k, vals := range resp.header {
+					w.Header()[k] = vals
+				}
+				w.WriteHeader(resp.statusCode)
+				_, _ = io.WriteString(w, resp.body)
+			}))
+			defer ts.Close()
+
+			c := &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+				retries:    tc.retries,
+			}
+			c.apiURL, _ = url.Parse(ts.URL)
+
+			req, err := c.NewRequest(tc.ctx, "POST", "/user", map[string]string{"name": "The Octocat"})
+			assert.NoError(t, err)
+
+			user := new(User)
+			resp, err := c.Do(req, user)
+
+			assert.Equal(t, tc.expectedCalls, calls)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.Equal(t, tc.expectedLogin, user.Login)
+			}
+		})
+	}
+}
+
+func TestClient_JitterWait(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        []Option
+		wait        time.Duration
+		expectedMin time.Duration
+		expectedMax time.Duration
+	}{
+		{
+			name:        "NoJitter",
+			opts:        nil,
+			wait:        time.Minute,
+			expectedMin: time.Minute,
+			expectedMax: time.Minute,
+		},
+		{
+			name:        "ZeroWait",
+			opts:        []Option{WithJitter(1, rand.NewSource(42))},
+			wait:        0,
+			expectedMin: 0,
+			expectedMax: 0,
+		},
+		{
+			name:        "HalfJitter",
+			opts:        []Option{WithJitter(0.5, rand.NewSource(42))},
+			wait:        time.Minute,
+			expectedMin: time.Minute,
+			expectedMax: 90 * time.Second,
+		},
+		{
+			name:        "FullJitter",
+			opts:        []Option{WithJitter(1, rand.NewSource(42))},
+			wait:        time.Minute,
+			expectedMin: time.Minute,
+			expectedMax: 2 * time.Minute,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := NewClient("", tc.opts...)
+
+			for i := 0; i < 1000; i++ {
+				wait := c.jitterWait(tc.wait)
+				assert.GreaterOrEqual(t, int64(wait), int64(tc.expectedMin))
+				assert.LessOrEqual(t, int64(wait), int64(tc.expectedMax))
+			}
+		})
+	}
+
+	t.Run("Deterministic", func(t *testing.T) {
+		c1 := NewClient("", WithJitter(1, rand.NewSource(42)))
+		c2 := NewClient("", WithJitter(1, rand.NewSource(42)))
+
+		for i := 0; i < 10; i++ {
+			assert.Equal(t, c1.jitterWait(time.Minute), c2.jitterWait(time.Minute))
+		}
+	})
+}
+
+func TestClient_Do_Timeout(t *testing.T) {
+	longCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	tests := []struct {
+		name          string
+		timeout       time.Duration
+		ctx           context.Context
+		expectedError string
+	}{
+		{
+			name:          "DeadlineExceeded",
+			timeout:       10 * time.Millisecond,
+			ctx:           context.Background(),
+			expectedError: `context deadline exceeded`,
+		},
+		{
+			name:    "ContextDeadline",
+			timeout: 10 * time.Millisecond,
+			ctx:     longCtx,
+		},
+		{
+			name:    "NoTimeout",
+			timeout: 0,
+			ctx:     context.Background(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				time.Sleep(100 * time.Millisecond)
+				_, _ = io.WriteString(w, `{"login": "octocat"}`)
+			}))
+			defer ts.Close()
+
+			c := &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+				timeout:    tc.timeout,
+			}
+			c.apiURL, _ = url.Parse(ts.URL)
+
+			req, err := c.NewRequest(tc.ctx, "GET", "/user", nil)
+			assert.NoError(t, err)
+
+			user := new(User)
+			resp, err := c.Do(req, user)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.Equal(t, "octocat", user.Login)
+			}
+		})
+	}
+}
+
+func TestClient_Do_Treat404AsEmpty(t *testing.T) {
+	tests := []struct {
+		name          string
+		emptyOn404    bool
+		body          interface{}
+		expectedBody  interface{}
+		expectedError string
+	}{
+		{
+			name:          "Disabled",
+			emptyOn404:    false,
+			body:          &[]Tag{},
+			expectedError: `GET /repos/octocat/Hello-World/tags: 404 Not Found`,
+		},
+		{
+			name:          "NotSlice",
+			emptyOn404:    true,
+			body:          new(Tag),
+			expectedError: `GET /repos/octocat/Hello-World/tags: 404 Not Found`,
+		},
+		{
+			name:          "RawMessage",
+			emptyOn404:    true,
+			body:          new(json.RawMessage),
+			expectedError: `GET /repos/octocat/Hello-World/tags: 404 Not Found`,
+		},
+		{
+			name:          "NilBody",
+			emptyOn404:    true,
+			body:          nil,
+			expectedError: `GET /repos/octocat/Hello-World/tags: 404 Not Found`,
+		},
+		{
+			name:         "Success",
+			emptyOn404:   true,
+			body:         &[]Tag{{Name: "v1.0.0"}},
+			expectedBody: &[]Tag{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(MockResponse{"GET", "/repos/octocat/Hello-World/tags", 404, header, `{
+				"message": "Not Found"
+			}`})
+			defer ts.Close()
+
+			c := &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+				emptyOn404: tc.emptyOn404,
+			}
+			c.apiURL, _ = url.Parse(ts.URL)
+
+			req, err := c.NewRequest(context.Background(), "GET", "/repos/octocat/Hello-World/tags", nil)
+			assert.NoError(t, err)
+
+			resp, err := c.Do(req, tc.body)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+				var notFoundErr *NotFoundError
+				assert.True(t, errors.As(err, &notFoundErr))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedBody, tc.body)
+				assert.NotNil(t, resp)
+				assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+			}
+		})
+	}
+}
+
+func TestClient_Do_Cache(t *testing.T) {
+	var calls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+
+		for k, vals := range header {
+			w.Header()[k] = vals
+		}
+
+		if r.Header.Get("If-None-Match") == `"644b5b0155e6404a9cc4bd9d8b1ae730"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("ETag", `"644b5b0155e6404a9cc4bd9d8b1ae730"`)
+		_, _ = io.WriteString(w, `{"login": "octocat"}`)
+	}))
+	defer ts.Close()
+
+	cache := mapCache{}
+
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		cache:      cache,
+	}
+	c.apiURL, _ = url.Parse(ts.URL)
+
+	for i := 0; i < 2; i++ {
+		req, err := c.NewRequest(context.Background(), "GET", "/user", nil)
+		assert.NoError(t, err)
+
+		user := new(User)
+		resp, err := c.Do(req, user)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+		assert.Equal(t, "octocat", user.Login)
+		assert.Equal(t, expectedRate, resp.Rate)
+	}
+
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, &CachedResponse{
+		ETag: `"644b5b0155e6404a9cc4bd9d8b1ae730"`,
+		Body: []byte(`{"login": "octocat"}`),
+	}, cache[ts.URL+"/user"])
+}
+
+func TestClient_Do_CacheWriter(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("If-None-Match"))
+
+		for k, vals := range header {
+			w.Header()[k] = vals
+		}
+		w.Header().Set("ETag", `"644b5b0155e6404a9cc4bd9d8b1ae730"`)
+		_, _ = io.WriteString(w, "archive content")
+	}))
+	defer ts.Close()
+
+	cache := mapCache{}
+
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		cache:      cache,
+	}
+	c.apiURL, _ = url.Parse(ts.URL)
+
+	for i := 0; i < 2; i++ {
+		req, err := c.NewRequest(context.Background(), "GET", "/repos/octocat/Hello-World/tarball/main", nil)
+		assert.NoError(t, err)
+
+		buf := new(bytes.Buffer)
+		resp, err := c.Do(req, buf)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+		assert.Equal(t, "archive content", buf.String())
+	}
+
+	assert.Empty(t, cache)
+}
+
+func TestClient_Do_Logger(t *testing.T) {
+	ts := newHTTPTestServer(MockResponse{"GET", "/user", 200, header, `{"login": "octocat"}`})
+	defer ts.Close()
+
+	type record struct {
+		method    string
+		path      string
+		auth      string
+		status    int
+		remaining string
+	}
+
+	var records []record
+	logger := func(ctx context.Context, req *http.Request, resp *http.Response) {
+		records = append(records, record{
+			method:    req.Method,
+			path:      req.URL.Path,
+			auth:      req.Header.Get("Authorization"),
+			status:    resp.StatusCode,
+			remaining: resp.Header.Get("X-RateLimit-Remaining"),
+		})
+	}
+
+	c := NewClient("access-token", WithLogger(logger))
+	c.apiURL, _ = url.Parse(ts.URL)
+
+	req, err := c.NewRequest(context.Background(), "GET", "/user", nil)
+	assert.NoError(t, err)
+
+	resp, err := c.Do(req, new(User))
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "token access-token", req.Header.Get("Authorization"))
+	assert.Equal(t, []record{
+		{method: "GET", path: "/user", auth: "", status: 200, remaining: "4990"},
+	}, records)
+}
+
+func TestClient_Do_Observer(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockResponse   MockResponse
+		reqURL         string
+		expectedGroup  string
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name:           "Success",
+			mockResponse:   MockResponse{"GET", "/user", 200, header, `{"login": "octocat"}`},
+			reqURL:         "/user",
+			expectedGroup:  "core",
+			expectedStatus: 200,
+		},
+		{
+			name: "Error",
+			mockResponse: MockResponse{"GET", "/search/repositories", 401, http.Header{}, `{
+				"message": "Bad credentials"
+			}`},
+			reqURL:         "/search/repositories",
+			expectedGroup:  "search",
+			expectedStatus: 401,
+			expectedError:  `GET /search/repositories: 401 Bad credentials`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponse)
+			defer ts.Close()
+
+			observer := new(mockObserver)
+
+			c := &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+				observer:   observer,
+			}
+			c.apiURL, _ = url.Parse(ts.URL)
+
+			req, err := c.NewRequest(context.Background(), "GET", tc.reqURL, nil)
+			assert.NoError(t, err)
+
+			_, err = c.Do(req, nil)
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Len(t, observer.observations, 1)
+			o := observer.observations[0]
+			assert.Equal(t, tc.expectedGroup, o.group)
+			assert.Equal(t, "GET", o.method)
+			assert.Equal(t, tc.reqURL, o.path)
+			assert.Equal(t, tc.expectedStatus, o.status)
+			assert.True(t, o.latency > 0)
+		})
+	}
+}
+
+func TestClient_Do_WithHTTPClient(t *testing.T) {
+	ts := newHTTPTestServer(MockResponse{"GET", "/user", 200, header, `{"login": "octocat"}`})
+	defer ts.Close()
+
+	var calls int
+	httpClient := &http.Client{
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			calls++
+			return http.DefaultTransport.RoundTrip(req)
+		}),
+	}
+
+	c := NewClient("", WithHTTPClient(httpClient))
+	c.apiURL, _ = url.Parse(ts.URL)
+
+	req, err := c.NewRequest(context.Background(), "GET", "/user", nil)
+	assert.NoError(t, err)
+
+	user := new(User)
+	resp, err := c.Do(req, user)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "octocat", user.Login)
+	assert.Equal(t, 1, calls)
+}
+
+func TestClient_EnsureScopes(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockResponses []MockResponse
+		ctx           context.Context
+		scopes        []Scope
+		expectedError string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			ctx:           nil,
+			scopes:        []Scope{ScopeRepo},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
 				{"HEAD", "/user", 401, http.Header{}, `bad credentials`},
 			},
 			ctx:           context.Background(),
@@ -753,6 +1919,256 @@ func TestClient_EnsureScopes(t *testing.T) {
 	}
 }
 
+func TestClient_RateLimits(t *testing.T) {
+	rateLimitBody := `{
+		"resources": {
+			"core": {
+				"limit": 5000,
+				"used": 10,
+				"remaining": 4990,
+				"reset": 1605083281
+			},
+			"search": {
+				"limit": 30,
+				"used": 12,
+				"remaining": 18,
+				"reset": 1605079741
+			},
+			"graphql": {
+				"limit": 5000,
+				"used": 7,
+				"remaining": 4993,
+				"reset": 1605083281
+			},
+			"integration_manifest": {
+				"limit": 5000,
+				"used": 1,
+				"remaining": 4999,
+				"reset": 1605083281
+			}
+		},
+		"rate": {
+			"limit": 5000,
+			"used": 10,
+			"remaining": 4990,
+			"reset": 1605083281
+		}
+	}`
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		rates            map[rateGroup]Rate
+		ctx              context.Context
+		expectedRates    map[string]Rate
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			rates:         map[rateGroup]Rate{},
+			ctx:           nil,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/rate_limit", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			rates:         map[rateGroup]Rate{},
+			ctx:           context.Background(),
+			expectedError: `GET /rate_limit: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/rate_limit", 200, http.Header{}, `{`},
+			},
+			rates:         map[rateGroup]Rate{},
+			ctx:           context.Background(),
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/rate_limit", 200, header, rateLimitBody},
+			},
+			rates: map[rateGroup]Rate{
+				// The rate limit endpoint is allowed even when the core rate limit is exhausted
+				rateGroupCore: {
+					Limit:     5000,
+					Used:      5000,
+					Remaining: 0,
+					Reset:     Epoch(time.Now().Add(time.Hour).Unix()),
+				},
+			},
+			ctx: context.Background(),
+			expectedRates: map[string]Rate{
+				"core":                 {Limit: 5000, Used: 10, Remaining: 4990, Reset: Epoch(1605083281)},
+				"search":               {Limit: 30, Used: 12, Remaining: 18, Reset: Epoch(1605079741)},
+				"graphql":              {Limit: 5000, Used: 7, Remaining: 4993, Reset: Epoch(1605083281)},
+				"integration_manifest": {Limit: 5000, Used: 1, Remaining: 4999, Reset: Epoch(1605083281)},
+			},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := &Client{
+				httpClient: &http.Client{},
+				rates:      tc.rates,
+			}
+
+			ts := newHTTPTestServer(tc.mockResponses...)
+			c.apiURL, _ = url.Parse(ts.URL)
+
+			rates, resp, err := c.RateLimits(tc.ctx)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, rates)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRates, rates)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestClient_Rates(t *testing.T) {
+	ts := newHTTPTestServer(
+		MockResponse{"GET", "/user", 200, header, `{"login": "octocat"}`},
+	)
+	defer ts.Close()
+
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+	}
+	c.apiURL, _ = url.Parse(ts.URL)
+
+	assert.Equal(t, map[string]Rate{}, c.Rates())
+
+	req, err := c.NewRequest(context.Background(), "GET", "/user", nil)
+	assert.NoError(t, err)
+
+	_, err = c.Do(req, nil)
+	assert.NoError(t, err)
+
+	rates := c.Rates()
+	assert.Equal(t, map[string]Rate{"core": expectedRate}, rates)
+
+	// The returned map is a copy
+	rates["core"] = Rate{}
+	assert.Equal(t, expectedRate, c.Rates()["core"])
+}
+
+func TestClient_Rates_NoRateHeaders(t *testing.T) {
+	ts := newHTTPTestServer(
+		MockResponse{"GET", "/user", 200, header, `{"login": "octocat"}`},
+		MockResponse{"GET", "/octocat.keys", 200, http.Header{}, "ssh-rsa AAAB3NzaC1yc2EAAAADAQABAAABAQCx"},
+	)
+	defer ts.Close()
+
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+	}
+	c.apiURL, _ = url.Parse(ts.URL)
+
+	req, err := c.NewRequest(context.Background(), "GET", "/user", nil)
+	assert.NoError(t, err)
+
+	_, err = c.Do(req, nil)
+	assert.NoError(t, err)
+
+	// A response without the rate limit headers does not reset the cached rate
+	req, err = c.NewRequest(context.Background(), "GET", "/octocat.keys", nil)
+	assert.NoError(t, err)
+
+	_, err = c.Do(req, ioutil.Discard)
+	assert.NoError(t, err)
+
+	assert.Equal(t, map[string]Rate{"core": expectedRate}, c.Rates())
+}
+
+func TestClient_NextReset(t *testing.T) {
+	now := time.Now().Unix()
+
+	tests := []struct {
+		name          string
+		c             *Client
+		expectedReset time.Time
+	}{
+		{
+			name: "NoRate",
+			c: &Client{
+				rates: map[rateGroup]Rate{},
+			},
+			expectedReset: time.Time{},
+		},
+		{
+			name: "Available",
+			c: &Client{
+				rates: map[rateGroup]Rate{
+					rateGroupCore:    {Limit: 5000, Remaining: 4990, Reset: Epoch(now + 3000)},
+					rateGroupSearch:  {Limit: 30, Remaining: 0, Reset: Epoch(now + 1000)},
+					rateGroupGraphQL: {Limit: 5000, Remaining: 100, Reset: Epoch(now + 2000)},
+				},
+			},
+			expectedReset: Epoch(now + 2000).Time(),
+		},
+		{
+			name: "AllExhausted",
+			c: &Client{
+				rates: map[rateGroup]Rate{
+					rateGroupCore:   {Limit: 5000, Remaining: 0, Reset: Epoch(now + 3000)},
+					rateGroupSearch: {Limit: 30, Remaining: 0, Reset: Epoch(now + 1000)},
+				},
+			},
+			expectedReset: Epoch(now + 1000).Time(),
+		},
+		{
+			name: "NoLimit",
+			c: &Client{
+				rates: map[rateGroup]Rate{
+					rateGroupCore:   {},
+					rateGroupSearch: {Limit: 30, Remaining: 0, Reset: Epoch(now + 1000)},
+				},
+			},
+			expectedReset: Epoch(now + 1000).Time(),
+		},
+		{
+			name: "PastReset",
+			c: &Client{
+				rates: map[rateGroup]Rate{
+					rateGroupCore:   {Limit: 5000, Remaining: 0, Reset: Epoch(1605083281)},
+					rateGroupSearch: {Limit: 30, Remaining: 10, Reset: Epoch(1605080000)},
+				},
+			},
+			expectedReset: time.Time{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			reset := tc.c.NextReset()
+
+			assert.Equal(t, tc.expectedReset, reset)
+		})
+	}
+}
+
 func TestClient_Repo(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -0,0 +1,168 @@
+package github
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// CodeOwnerRule is a single rule in a CODEOWNERS file.
+// An empty list of owners means the matching paths have no owners.
+type CodeOwnerRule struct {
+	Pattern string
+	Owners  []string
+
+	// re is the compiled pattern, set when the rule is parsed from a CODEOWNERS file.
+	re *regexp.Regexp
+}
+
+// Match determines whether or not a path in the repository matches the rule pattern.
+// Patterns follow the CODEOWNERS syntax, which is a subset of the gitignore syntax.
+// A rule that is not parsed from a CODEOWNERS file compiles its pattern on every call and does not match anything if the pattern is invalid.
+// See https://docs.github.com/en/github/creating-cloning-and-archiving-repositories/about-code-owners#codeowners-syntax
+func (r CodeOwnerRule) Match(path string) bool {
+	re := r.re
+	if re == nil {
+		var err error
+		if re, err = compilePattern(r.Pattern); err != nil {
+			return false
+		}
+	}
+
+	return re.MatchString(strings.TrimPrefix(path, "/"))
+}
+
+// CodeOwners is a parsed CODEOWNERS file.
+type CodeOwners struct {
+	// Path is the location of the CODEOWNERS file in the repository.
+	Path  string
+	Rules []CodeOwnerRule
+}
+
+// Match returns the owners of a path in the repository.
+// The last matching rule takes precedence, so the order of rules matters.
+// If no rule matches the path, nil is returned.
+func (c *CodeOwners) Match(path string) []string {
+	for i := len(c.Rules) - 1; i >= 0; i-- {
+		if c.Rules[i].Match(path) {
+			return c.Rules[i].Owners
+		}
+	}
+
+	return nil
+}
+
+// parseCodeOwners parses the content of a CODEOWNERS file.
+// Blank lines and comments are skipped, and owners listed after an inline comment are ignored.
+// An error is returned if a pattern uses a syntax that CODEOWNERS files do not support.
+func parseCodeOwners(path string, content []byte) (*CodeOwners, error) {
+	c := &CodeOwners{
+		Path:  path,
+		Rules: []CodeOwnerRule{},
+	}
+
+	line := 0
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line++
+
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		re, err := compilePattern(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %s", path, line, err)
+		}
+
+		rule := CodeOwnerRule{
+			Pattern: fields[0],
+			Owners:  []string{},
+			re:      re,
+		}
+
+		for _, owner := range fields[1:] {
+			if strings.HasPrefix(owner, "#") {
+				break
+			}
+			rule.Owners = append(rule.Owners, owner)
+		}
+
+		c.Rules = append(c.Rules, rule)
+	}
+
+	return c, nil
+}
+
+// compilePattern validates a CODEOWNERS pattern and compiles it to a regular expression.
+// Escaping, negation, and character ranges work in gitignore files but not in CODEOWNERS files.
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	switch {
+	case strings.Contains(pattern, `\`):
+		return nil, fmt.Errorf("invalid CODEOWNERS pattern %q: escaping is not supported", pattern)
+	case strings.HasPrefix(pattern, "!"):
+		return nil, fmt.Errorf("invalid CODEOWNERS pattern %q: negation is not supported", pattern)
+	case strings.ContainsAny(pattern, "[]"):
+		return nil, fmt.Errorf("invalid CODEOWNERS pattern %q: character ranges are not supported", pattern)
+	}
+
+	return regexp.Compile(patternToRegexp(pattern))
+}
+
+// patternToRegexp converts a CODEOWNERS pattern to an equivalent regular expression.
+func patternToRegexp(pattern string) string {
+	p := pattern
+
+	// A pattern with a leading or middle slash is relative to the root of the repository.
+	// Otherwise, it can match at any depth.
+	anchored := strings.HasPrefix(p, "/") || strings.Contains(strings.TrimSuffix(p, "/"), "/")
+	p = strings.TrimPrefix(p, "/")
+
+	// A pattern with a trailing slash only matches directories.
+	dirOnly := strings.HasSuffix(p, "/")
+	p = strings.TrimSuffix(p, "/")
+
+	var b strings.Builder
+
+	if anchored {
+		b.WriteString("^")
+	} else {
+		b.WriteString("^(?:.*/)?")
+	}
+
+	for i := 0; i < len(p); i++ {
+		switch {
+		case strings.HasPrefix(p[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(p[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case p[i] == '*':
+			b.WriteString("[^/]*")
+		case p[i] == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(p[i : i+1]))
+		}
+	}
+
+	lastSegment := p[strings.LastIndex(p, "/")+1:]
+
+	switch {
+	case dirOnly:
+		// A directory pattern matches everything under the directory.
+		b.WriteString("/.*$")
+	case strings.ContainsAny(lastSegment, "*?"):
+		// A wildcard in the last segment does not match nested paths (e.g. docs/* does not match docs/a/b.md).
+		b.WriteString("$")
+	default:
+		// A path without a trailing slash can be a file or a directory.
+		b.WriteString("(?:/.*)?$")
+	}
+
+	return b.String()
+}
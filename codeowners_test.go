@@ -0,0 +1,203 @@
+package github
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	codeOwnersContent = `# This is a comment.
+# Each line is a file pattern followed by one or more owners.
+
+*       @global-owner1 @global-owner2
+
+*.js    @js-owner # This is an inline comment.
+*.go docs@example.com
+
+/build/logs/ @doctocat
+docs/*  docs@example.com
+apps/ @octocat
+/docs/ @doctocat
+/scripts/ @doctocat @octocat
+**/logs @octocat
+/apps/github
+`
+)
+
+func mustCompilePattern(pattern string) *regexp.Regexp {
+	re, err := compilePattern(pattern)
+	if err != nil {
+		panic(err)
+	}
+
+	return re
+}
+
+var (
+	codeOwners = &CodeOwners{
+		Path: ".github/CODEOWNERS",
+		Rules: []CodeOwnerRule{
+			{Pattern: "*", Owners: []string{"@global-owner1", "@global-owner2"}, re: mustCompilePattern("*")},
+			{Pattern: "*.js", Owners: []string{"@js-owner"}, re: mustCompilePattern("*.js")},
+			{Pattern: "*.go", Owners: []string{"docs@example.com"}, re: mustCompilePattern("*.go")},
+			{Pattern: "/build/logs/", Owners: []string{"@doctocat"}, re: mustCompilePattern("/build/logs/")},
+			{Pattern: "docs/*", Owners: []string{"docs@example.com"}, re: mustCompilePattern("docs/*")},
+			{Pattern: "apps/", Owners: []string{"@octocat"}, re: mustCompilePattern("apps/")},
+			{Pattern: "/docs/", Owners: []string{"@doctocat"}, re: mustCompilePattern("/docs/")},
+			{Pattern: "/scripts/", Owners: []string{"@doctocat", "@octocat"}, re: mustCompilePattern("/scripts/")},
+			{Pattern: "**/logs", Owners: []string{"@octocat"}, re: mustCompilePattern("**/logs")},
+			{Pattern: "/apps/github", Owners: []string{}, re: mustCompilePattern("/apps/github")},
+		},
+	}
+)
+
+func TestParseCodeOwners(t *testing.T) {
+	tests := []struct {
+		name               string
+		path               string
+		content            string
+		expectedCodeOwners *CodeOwners
+		expectedError      string
+	}{
+		{
+			name:    "Empty",
+			path:    "CODEOWNERS",
+			content: "",
+			expectedCodeOwners: &CodeOwners{
+				Path:  "CODEOWNERS",
+				Rules: []CodeOwnerRule{},
+			},
+		},
+		{
+			name:               "OK",
+			path:               ".github/CODEOWNERS",
+			content:            codeOwnersContent,
+			expectedCodeOwners: codeOwners,
+		},
+		{
+			name:          "Escaping",
+			path:          "CODEOWNERS",
+			content:       "* @octocat\n\\#notes.md @doctocat\n",
+			expectedError: `CODEOWNERS:2: invalid CODEOWNERS pattern "\\#notes.md": escaping is not supported`,
+		},
+		{
+			name:          "Negation",
+			path:          "CODEOWNERS",
+			content:       "# Comment\n\n!*.js @octocat\n",
+			expectedError: `CODEOWNERS:3: invalid CODEOWNERS pattern "!*.js": negation is not supported`,
+		},
+		{
+			name:          "CharacterRange",
+			path:          "docs/CODEOWNERS",
+			content:       "file[0-9].txt @octocat\n",
+			expectedError: `docs/CODEOWNERS:1: invalid CODEOWNERS pattern "file[0-9].txt": character ranges are not supported`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := parseCodeOwners(tc.path, []byte(tc.content))
+
+			if tc.expectedError != "" {
+				assert.Nil(t, c)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedCodeOwners, c)
+			}
+		})
+	}
+}
+
+func TestCodeOwnerRule_Match(t *testing.T) {
+	tests := []struct {
+		name          string
+		pattern       string
+		path          string
+		expectedMatch bool
+	}{
+		{"Wildcard", "*", "README.md", true},
+		{"WildcardNested", "*", "src/main.go", true},
+		{"ExtensionRoot", "*.js", "index.js", true},
+		{"ExtensionNested", "*.js", "src/app/index.js", true},
+		{"ExtensionMismatch", "*.js", "index.ts", false},
+		{"AnchoredDir", "/build/logs/", "build/logs/2020/output.log", true},
+		{"AnchoredDirNotRoot", "/build/logs/", "src/build/logs/output.log", false},
+		{"AnchoredDirItself", "/build/logs/", "build/logs", false},
+		{"DirWildcard", "docs/*", "docs/getting-started.md", true},
+		{"DirWildcardNested", "docs/*", "docs/build-app/troubleshooting.md", false},
+		{"UnanchoredDir", "apps/", "apps/web/index.js", true},
+		{"UnanchoredDirNested", "apps/", "src/apps/web/index.js", true},
+		{"DoubleStar", "**/logs", "logs/output.log", true},
+		{"DoubleStarNested", "**/logs", "deeply/nested/logs/output.log", true},
+		{"DoubleStarTrailing", "src/**", "src/a/b/c.go", true},
+		{"DoubleStarMiddle", "src/**/test.go", "src/a/b/test.go", true},
+		{"DoubleStarMiddleDirect", "src/**/test.go", "src/test.go", true},
+		{"QuestionMark", "file?.txt", "file1.txt", true},
+		{"QuestionMarkSlash", "a?b", "a/b", false},
+		{"FileOrDir", "/apps/github", "apps/github/main.go", true},
+		{"FileExact", "/apps/github", "apps/github", true},
+		{"FilePrefix", "/apps/github", "apps/githubber", false},
+		{"LeadingSlashPath", "/docs/", "/docs/index.md", true},
+		{"LiteralDot", "*.go", "main_go", false},
+		{"InvalidPattern", "!*.go", "main.go", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := CodeOwnerRule{
+				Pattern: tc.pattern,
+			}
+
+			assert.Equal(t, tc.expectedMatch, r.Match(tc.path))
+		})
+	}
+}
+
+func TestCodeOwners_Match(t *testing.T) {
+	tests := []struct {
+		name           string
+		c              *CodeOwners
+		path           string
+		expectedOwners []string
+	}{
+		{
+			name:           "NoRules",
+			c:              &CodeOwners{},
+			path:           "README.md",
+			expectedOwners: nil,
+		},
+		{
+			name:           "Default",
+			c:              codeOwners,
+			path:           "README.md",
+			expectedOwners: []string{"@global-owner1", "@global-owner2"},
+		},
+		{
+			name:           "LastMatchWins",
+			c:              codeOwners,
+			path:           "scripts/deploy.js",
+			expectedOwners: []string{"@doctocat", "@octocat"},
+		},
+		{
+			name:           "Extension",
+			c:              codeOwners,
+			path:           "src/index.js",
+			expectedOwners: []string{"@js-owner"},
+		},
+		{
+			name:           "NoOwners",
+			c:              codeOwners,
+			path:           "apps/github/main.go",
+			expectedOwners: []string{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedOwners, tc.c.Match(tc.path))
+		})
+	}
+}
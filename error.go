@@ -1,24 +1,75 @@
 package github
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"sort"
+	"strings"
 	"time"
 )
 
+// ErrNotModified is returned when GitHub responds with 304 Not Modified to a conditional request.
+// Callers can use it to skip reprocessing results that have not changed.
+var ErrNotModified = errors.New("not modified")
+
 // ResponseError is a generic error for HTTP calls to GitHub API v3.
 // See https://docs.github.com/en/free-pro-team@latest/rest/overview/resources-in-the-rest-api#client-errors
 type ResponseError struct {
 	Response         *http.Response
 	Message          string `json:"message"`
 	DocumentationURL string `json:"documentation_url,omitempty"`
+
+	// Errors are the details of validation failures, usually included in 422 responses.
+	Errors []ResponseErrorDetail `json:"errors,omitempty"`
+
+	// Body is the raw response body, which can contain more details such as validation errors.
+	Body []byte `json:"-"`
 }
 
 func (e *ResponseError) Error() string {
-	return fmt.Sprintf("%s %s: %d %s",
+	msg := fmt.Sprintf("%s %s: %d %s",
 		e.Response.Request.Method, e.Response.Request.URL.Path,
 		e.Response.StatusCode, e.Message,
 	)
+
+	if len(e.Errors) > 0 {
+		msg += fmt.Sprintf(" (%s)", e.Errors[0])
+	}
+
+	return msg
+}
+
+// ResponseErrorDetail is the detail of a validation failure for a resource field.
+// See https://docs.github.com/rest/overview/resources-in-the-rest-api#client-errors
+type ResponseErrorDetail struct {
+	Resource string `json:"resource"`
+	Field    string `json:"field"`
+	Code     string `json:"code"`
+	Message  string `json:"message,omitempty"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Some endpoints report errors as plain strings, which are decoded into the Message field.
+func (d *ResponseErrorDetail) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*d = ResponseErrorDetail{Message: s}
+		return nil
+	}
+
+	// Use a different type to avoid infinite recursion
+	type detail ResponseErrorDetail
+	return json.Unmarshal(b, (*detail)(d))
+}
+
+func (d ResponseErrorDetail) String() string {
+	if d.Message != "" {
+		return d.Message
+	}
+
+	return fmt.Sprintf("%s.%s %s", d.Resource, d.Field, d.Code)
 }
 
 // AuthError occurs when there is an authentication problem.
@@ -92,3 +143,61 @@ func (e *NotFoundError) Error() string {
 func (e *NotFoundError) Unwrap() error {
 	return e.err
 }
+
+// ConflictError occurs when a request conflicts with the current state of a resource.
+// For example, merging a pull request with a stale head SHA or updating a file concurrently.
+type ConflictError struct {
+	err *ResponseError
+}
+
+func (e *ConflictError) Error() string {
+	if e.err == nil {
+		return "resource conflict"
+	}
+
+	return e.err.Error()
+}
+
+func (e *ConflictError) Unwrap() error {
+	return e.err
+}
+
+// ValidationError occurs when a request is well-formed but contains invalid fields.
+// Errors holds the field-level problems reported by GitHub.
+type ValidationError struct {
+	err    *ResponseError
+	Errors []ResponseErrorDetail
+}
+
+func (e *ValidationError) Error() string {
+	if e.err == nil {
+		return "validation failed"
+	}
+
+	return e.err.Error()
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.err
+}
+
+// CommitsError occurs when one or more commits cannot be retrieved.
+// It maps each failed commit SHA to its error.
+type CommitsError struct {
+	Errors map[string]error
+}
+
+func (e *CommitsError) Error() string {
+	shas := make([]string, 0, len(e.Errors))
+	for sha := range e.Errors {
+		shas = append(shas, sha)
+	}
+	sort.Strings(shas)
+
+	msgs := make([]string, len(shas))
+	for i, sha := range shas {
+		msgs[i] = fmt.Sprintf("%s: %s", sha, e.Errors[sha])
+	}
+
+	return fmt.Sprintf("failed to retrieve %d commit(s): %s", len(shas), strings.Join(msgs, "; "))
+}
@@ -1,6 +1,8 @@
 package github
 
 import (
+	"encoding/json"
+	"errors"
 	"net/http"
 	"testing"
 	"time"
@@ -28,6 +30,22 @@ func TestResponseError(t *testing.T) {
 			},
 			expectedError: "PATCH /user: 400 Problems parsing JSON",
 		},
+		{
+			name: "WithErrors",
+			err: &ResponseError{
+				Response: &http.Response{
+					StatusCode: 422,
+					Request:    req,
+				},
+				Message:          "Validation Failed",
+				DocumentationURL: "https://docs.github.com/rest/reference/users#update-the-authenticated-user",
+				Errors: []ResponseErrorDetail{
+					{Resource: "User", Field: "email", Code: "invalid"},
+					{Resource: "User", Field: "name", Code: "missing_field"},
+				},
+			},
+			expectedError: "PATCH /user: 422 Validation Failed (User.email invalid)",
+		},
 	}
 
 	for _, tc := range tests {
@@ -37,6 +55,66 @@ func TestResponseError(t *testing.T) {
 	}
 }
 
+func TestResponseErrorDetail_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name           string
+		data           string
+		expectedDetail ResponseErrorDetail
+		expectedString string
+		expectedError  string
+	}{
+		{
+			name:          "Invalid",
+			data:          `1`,
+			expectedError: `json: cannot unmarshal number into Go value of type github.detail`,
+		},
+		{
+			name: "Object",
+			data: `{"resource": "Issue", "field": "title", "code": "missing_field"}`,
+			expectedDetail: ResponseErrorDetail{
+				Resource: "Issue",
+				Field:    "title",
+				Code:     "missing_field",
+			},
+			expectedString: "Issue.title missing_field",
+		},
+		{
+			name: "ObjectWithMessage",
+			data: `{"resource": "Issue", "field": "title", "code": "custom", "message": "title is too long"}`,
+			expectedDetail: ResponseErrorDetail{
+				Resource: "Issue",
+				Field:    "title",
+				Code:     "custom",
+				Message:  "title is too long",
+			},
+			expectedString: "title is too long",
+		},
+		{
+			name: "String",
+			data: `"Can not approve your own pull request"`,
+			expectedDetail: ResponseErrorDetail{
+				Message: "Can not approve your own pull request",
+			},
+			expectedString: "Can not approve your own pull request",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var d ResponseErrorDetail
+			err := json.Unmarshal([]byte(tc.data), &d)
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedDetail, d)
+				assert.Equal(t, tc.expectedString, d.String())
+			}
+		})
+	}
+}
+
 func TestAuthError(t *testing.T) {
 	req, _ := http.NewRequest("GET", "/user", nil)
 
@@ -214,3 +292,108 @@ func TestNotFoundError(t *testing.T) {
 		})
 	}
 }
+
+func TestConflictError(t *testing.T) {
+	req, _ := http.NewRequest("PUT", "/repos/octocat/Hello-World/pulls/1002/merge", nil)
+
+	tests := []struct {
+		name          string
+		err           *ConflictError
+		expectedError string
+	}{
+		{
+			name:          "WithoutResponseError",
+			err:           &ConflictError{},
+			expectedError: "resource conflict",
+		},
+		{
+			name: "WithResponseError",
+			err: &ConflictError{
+				err: &ResponseError{
+					Response: &http.Response{
+						StatusCode: 409,
+						Request:    req,
+					},
+					Message:          "Head branch was modified. Review and try the merge again.",
+					DocumentationURL: "https://docs.github.com/rest/reference/pulls#merge-a-pull-request",
+				},
+			},
+			expectedError: "PUT /repos/octocat/Hello-World/pulls/1002/merge: 409 Head branch was modified. Review and try the merge again.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.EqualError(t, tc.err, tc.expectedError)
+			assert.Equal(t, tc.err.err, tc.err.Unwrap())
+		})
+	}
+}
+
+func TestValidationError(t *testing.T) {
+	req, _ := http.NewRequest("POST", "/repos/octocat/Hello-World/issues", nil)
+
+	tests := []struct {
+		name          string
+		err           *ValidationError
+		expectedError string
+	}{
+		{
+			name:          "WithoutResponseError",
+			err:           &ValidationError{},
+			expectedError: "validation failed",
+		},
+		{
+			name: "WithResponseError",
+			err: &ValidationError{
+				err: &ResponseError{
+					Response: &http.Response{
+						StatusCode: 422,
+						Request:    req,
+					},
+					Message: "Validation Failed",
+					Errors: []ResponseErrorDetail{
+						{Resource: "Issue", Field: "title", Code: "missing_field"},
+					},
+					DocumentationURL: "https://docs.github.com/rest/reference/issues#create-an-issue",
+				},
+				Errors: []ResponseErrorDetail{
+					{Resource: "Issue", Field: "title", Code: "missing_field"},
+				},
+			},
+			expectedError: "POST /repos/octocat/Hello-World/issues: 422 Validation Failed (Issue.title missing_field)",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.EqualError(t, tc.err, tc.expectedError)
+			assert.Equal(t, tc.err.err, tc.err.Unwrap())
+		})
+	}
+}
+
+func TestCommitsError(t *testing.T) {
+	tests := []struct {
+		name          string
+		err           *CommitsError
+		expectedError string
+	}{
+		{
+			name: "OK",
+			err: &CommitsError{
+				Errors: map[string]error{
+					"c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c": errors.New("resource not found"),
+					"6dcb09b5b57875f334f61aebed695e2e4193db5e": errors.New("requires authentication"),
+				},
+			},
+			expectedError: "failed to retrieve 2 commit(s): 6dcb09b5b57875f334f61aebed695e2e4193db5e: requires authentication; c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c: resource not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.EqualError(t, tc.err, tc.expectedError)
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package github
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// FileCache is a Cache that stores responses as files in a directory, so they persist between runs of a program.
+// Each response is stored in a file named after the SHA-256 hash of its URL.
+// Other files in the directory are never read or removed by the cache.
+// A FileCache is safe for concurrent use by a single process.
+type FileCache struct {
+	mutex   sync.Mutex
+	dir     string
+	maxSize int64
+	ttl     time.Duration
+}
+
+// NewFileCache creates a new file cache in a given directory.
+// The directory is created if it does not exist.
+// maxSize is the maximum total size of the cached files in bytes; when exceeded, the oldest files are evicted.
+// ttl is how long a cached response is kept after it is stored.
+// A zero maxSize or ttl means no limit.
+func NewFileCache(dir string, maxSize int64, ttl time.Duration) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	return &FileCache{
+		dir:     dir,
+		maxSize: maxSize,
+		ttl:     ttl,
+	}, nil
+}
+
+func (c *FileCache) path(url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:]))
+}
+
+// isCacheFile determines whether or not a file name is a hex-encoded SHA-256 hash created by the cache.
+func isCacheFile(name string) bool {
+	if len(name) != 2*sha256.Size {
+		return false
+	}
+
+	for _, r := range name {
+		if (r < '0' || r > '9') && (r < 'a' || r > 'f') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Get implements the Cache interface.
+// An expired response is removed from the cache and reported as a miss.
+func (c *FileCache) Get(url string) (*CachedResponse, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	path := c.path(url)
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, false
+	}
+
+	if c.ttl > 0 && time.Since(info.ModTime()) > c.ttl {
+		_ = os.Remove(path)
+		return nil, false
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+
+	// The first line of the file is the ETag and the rest is the body
+	i := bytes.IndexByte(b, '\n')
+	if i < 0 {
+		return nil, false
+	}
+
+	return &CachedResponse{
+		ETag: string(b[:i]),
+		Body: b[i+1:],
+	}, true
+}
+
+// Set implements the Cache interface.
+// Errors are ignored, since failing to cache a response only costs a future request.
+func (c *FileCache) Set(url string, resp *CachedResponse) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	b := make([]byte, 0, len(resp.ETag)+1+len(resp.Body))
+	b = append(b, resp.ETag...)
+	b = append(b, '\n')
+	b = append(b, resp.Body...)
+
+	if err := ioutil.WriteFile(c.path(url), b, 0600); err != nil {
+		return
+	}
+
+	c.evict()
+}
+
+// evict removes expired files and then the oldest files until the total size is within the limit.
+// Only the files created by the cache are considered.
+func (c *FileCache) evict() {
+	infos, err := ioutil.ReadDir(c.dir)
+	if err != nil {
+		return
+	}
+
+	// Oldest first
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].ModTime().Before(infos[j].ModTime())
+	})
+
+	var size int64
+	live := infos[:0]
+	for _, info := range infos {
+		if info.IsDir() || !isCacheFile(info.Name()) {
+			continue
+		}
+
+		if c.ttl > 0 && time.Since(info.ModTime()) > c.ttl {
+			_ = os.Remove(filepath.Join(c.dir, info.Name()))
+			continue
+		}
+
+		size += info.Size()
+		live = append(live, info)
+	}
+
+	for _, info := range live {
+		if c.maxSize <= 0 || size <= c.maxSize {
+			break
+		}
+
+		if err := os.Remove(filepath.Join(c.dir, info.Name())); err == nil {
+			size -= info.Size()
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package github
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFileCache(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "cache")
+
+	c, err := NewFileCache(dir, 1024, time.Hour)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, c)
+	assert.Equal(t, dir, c.dir)
+	assert.Equal(t, int64(1024), c.maxSize)
+	assert.Equal(t, time.Hour, c.ttl)
+	assert.DirExists(t, dir)
+}
+
+func TestFileCache(t *testing.T) {
+	const (
+		url1 = "https://api.github.com/repos/octocat/Hello-World"
+		url2 = "https://api.github.com/repos/octocat/Hello-World/issues"
+		url3 = "https://api.github.com/repos/octocat/Hello-World/pulls"
+	)
+
+	resp1 := &CachedResponse{ETag: `"etag-1"`, Body: []byte(`{"id": 1}`)}
+	resp2 := &CachedResponse{ETag: `"etag-2"`, Body: []byte(`[{"id": 2}]`)}
+	resp3 := &CachedResponse{ETag: `"etag-3"`, Body: []byte(`[{"id": 3}]`)}
+
+	// age sets the modification time of the cached file for a URL to a given duration ago.
+	age := func(t *testing.T, c *FileCache, url string, d time.Duration) {
+		mtime := time.Now().Add(-d)
+		assert.NoError(t, os.Chtimes(c.path(url), mtime, mtime))
+	}
+
+	t.Run("Miss", func(t *testing.T) {
+		c, err := NewFileCache(t.TempDir(), 0, 0)
+		assert.NoError(t, err)
+
+		resp, ok := c.Get(url1)
+
+		assert.False(t, ok)
+		assert.Nil(t, resp)
+	})
+
+	t.Run("Hit", func(t *testing.T) {
+		dir := t.TempDir()
+
+		c, err := NewFileCache(dir, 0, 0)
+		assert.NoError(t, err)
+
+		c.Set(url1, resp1)
+		c.Set(url2, resp2)
+
+		// A new cache on the same directory reads the responses stored by the previous one
+		c, err = NewFileCache(dir, 0, 0)
+		assert.NoError(t, err)
+
+		resp, ok := c.Get(url1)
+		assert.True(t, ok)
+		assert.Equal(t, resp1, resp)
+
+		resp, ok = c.Get(url2)
+		assert.True(t, ok)
+		assert.Equal(t, resp2, resp)
+	})
+
+	t.Run("HashedKeys", func(t *testing.T) {
+		dir := t.TempDir()
+
+		c, err := NewFileCache(dir, 0, 0)
+		assert.NoError(t, err)
+
+		c.Set(url1, resp1)
+
+		infos, err := ioutil.ReadDir(dir)
+		assert.NoError(t, err)
+		assert.Len(t, infos, 1)
+		assert.Regexp(t, `^[0-9a-f]{64}$`, infos[0].Name())
+	})
+
+	t.Run("Overwrite", func(t *testing.T) {
+		c, err := NewFileCache(t.TempDir(), 0, 0)
+		assert.NoError(t, err)
+
+		c.Set(url1, resp1)
+		c.Set(url1, resp2)
+
+		resp, ok := c.Get(url1)
+		assert.True(t, ok)
+		assert.Equal(t, resp2, resp)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		c, err := NewFileCache(t.TempDir(), 0, time.Hour)
+		assert.NoError(t, err)
+
+		c.Set(url1, resp1)
+		c.Set(url2, resp2)
+		age(t, c, url1, 2*time.Hour)
+
+		resp, ok := c.Get(url1)
+		assert.False(t, ok)
+		assert.Nil(t, resp)
+		assert.NoFileExists(t, c.path(url1))
+
+		resp, ok = c.Get(url2)
+		assert.True(t, ok)
+		assert.Equal(t, resp2, resp)
+	})
+
+	t.Run("EvictExpired", func(t *testing.T) {
+		c, err := NewFileCache(t.TempDir(), 0, time.Hour)
+		assert.NoError(t, err)
+
+		c.Set(url1, resp1)
+		age(t, c, url1, 2*time.Hour)
+		c.Set(url2, resp2)
+
+		assert.NoFileExists(t, c.path(url1))
+		assert.FileExists(t, c.path(url2))
+	})
+
+	t.Run("EvictOldest", func(t *testing.T) {
+		// The files are 18 to 20 bytes (ETag, newline, and body), so only the two newest files fit
+		c, err := NewFileCache(t.TempDir(), 40, 0)
+		assert.NoError(t, err)
+
+		c.Set(url1, resp1)
+		age(t, c, url1, 2*time.Minute)
+		c.Set(url2, resp2)
+		age(t, c, url2, time.Minute)
+		c.Set(url3, resp3)
+
+		resp, ok := c.Get(url1)
+		assert.False(t, ok)
+		assert.Nil(t, resp)
+
+		resp, ok = c.Get(url2)
+		assert.True(t, ok)
+		assert.Equal(t, resp2, resp)
+
+		resp, ok = c.Get(url3)
+		assert.True(t, ok)
+		assert.Equal(t, resp3, resp)
+	})
+
+	t.Run("EvictKeepsForeignFiles", func(t *testing.T) {
+		dir := t.TempDir()
+		foreign := filepath.Join(dir, "notes.txt")
+		assert.NoError(t, ioutil.WriteFile(foreign, []byte("not a cached response"), 0600))
+		mtime := time.Now().Add(-2 * time.Hour)
+		assert.NoError(t, os.Chtimes(foreign, mtime, mtime))
+
+		// The foreign file is older than the TTL and does not fit in the max size
+		c, err := NewFileCache(dir, 20, time.Hour)
+		assert.NoError(t, err)
+
+		c.Set(url1, resp1)
+		age(t, c, url1, time.Minute)
+		c.Set(url2, resp2)
+
+		assert.FileExists(t, foreign)
+		assert.NoFileExists(t, c.path(url1))
+		assert.FileExists(t, c.path(url2))
+	})
+}
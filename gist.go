@@ -0,0 +1,148 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// GistsService provides GitHub APIs for gists.
+// Creating and deleting gists requires the access token to have the gist scope (see EnsureScopes).
+// See https://docs.github.com/en/rest/reference/gists
+type GistsService struct {
+	client *Client
+}
+
+type (
+	// GistFile is a file in a GitHub gist.
+	// Content is only included when retrieving a single gist.
+	GistFile struct {
+		Filename string `json:"filename"`
+		Content  string `json:"content"`
+		Language string `json:"language"`
+		RawURL   string `json:"raw_url"`
+		Size     int    `json:"size"`
+	}
+
+	// Gist is a GitHub gist object.
+	Gist struct {
+		ID          string              `json:"id"`
+		Description string              `json:"description"`
+		Public      bool                `json:"public"`
+		Files       map[string]GistFile `json:"files"`
+		HTMLURL     string              `json:"html_url"`
+		CreatedAt   time.Time           `json:"created_at"`
+	}
+)
+
+// GistParams is used for creating a GitHub gist.
+// Files maps file names to their contents.
+type GistParams struct {
+	Description string
+	Public      bool
+	Files       map[string]string
+}
+
+// Validate checks the required fields of a GistParams before sending it to GitHub.
+func (p GistParams) Validate() error {
+	if len(p.Files) == 0 {
+		return errors.New("invalid gist params: at least one file is required")
+	}
+
+	return nil
+}
+
+// Get retrieves a gist by its id.
+// See https://docs.github.com/rest/reference/gists#get-a-gist
+func (s *GistsService) Get(ctx context.Context, id string) (*Gist, *Response, error) {
+	url := fmt.Sprintf("/gists/%s", id)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gist := new(Gist)
+
+	resp, err := s.client.Do(req, gist)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return gist, resp, nil
+}
+
+// List retrieves all gists of the authenticated user page by page.
+// See https://docs.github.com/rest/reference/gists#list-gists-for-the-authenticated-user
+func (s *GistsService) List(ctx context.Context, pageSize, pageNo int) ([]Gist, *Response, error) {
+	req, err := s.client.NewPageRequest(ctx, "GET", "/gists", pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gists := []Gist{}
+
+	resp, err := s.client.Do(req, &gists)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return gists, resp, nil
+}
+
+// Create creates a new gist for the authenticated user.
+// See https://docs.github.com/rest/reference/gists#create-a-gist
+func (s *GistsService) Create(ctx context.Context, params GistParams) (*Gist, *Response, error) {
+	if err := params.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	type file struct {
+		Content string `json:"content"`
+	}
+
+	body := struct {
+		Description string          `json:"description,omitempty"`
+		Public      bool            `json:"public"`
+		Files       map[string]file `json:"files"`
+	}{
+		Description: params.Description,
+		Public:      params.Public,
+		Files:       map[string]file{},
+	}
+
+	for name, content := range params.Files {
+		body.Files[name] = file{Content: content}
+	}
+
+	req, err := s.client.NewRequest(ctx, "POST", "/gists", body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gist := new(Gist)
+
+	resp, err := s.client.Do(req, gist)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return gist, resp, nil
+}
+
+// Delete deletes a gist by its id.
+// See https://docs.github.com/rest/reference/gists#delete-a-gist
+func (s *GistsService) Delete(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("/gists/%s", id)
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
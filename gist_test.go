@@ -0,0 +1,491 @@
+package github
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	gistBody = `{
+		"id": "aa5a315d61ae9438b18d",
+		"description": "Hello World Examples",
+		"public": true,
+		"html_url": "https://gist.github.com/aa5a315d61ae9438b18d",
+		"files": {
+			"hello_world.rb": {
+				"filename": "hello_world.rb",
+				"type": "application/x-ruby",
+				"language": "Ruby",
+				"raw_url": "https://gist.githubusercontent.com/octocat/6cad326836d38bd3a7ae/raw/db9c55113504e46fa076e7df3a04ce592e2e86d8/hello_world.rb",
+				"size": 167,
+				"content": "class HelloWorld\nend"
+			}
+		},
+		"created_at": "2020-10-20T20:00:00Z"
+	}`
+
+	gistsBody = `[
+		{
+			"id": "aa5a315d61ae9438b18d",
+			"description": "Hello World Examples",
+			"public": true,
+			"html_url": "https://gist.github.com/aa5a315d61ae9438b18d",
+			"files": {
+				"hello_world.rb": {
+					"filename": "hello_world.rb",
+					"type": "application/x-ruby",
+					"language": "Ruby",
+					"raw_url": "https://gist.githubusercontent.com/octocat/6cad326836d38bd3a7ae/raw/db9c55113504e46fa076e7df3a04ce592e2e86d8/hello_world.rb",
+					"size": 167,
+					"content": "class HelloWorld\nend"
+				}
+			},
+			"created_at": "2020-10-20T20:00:00Z"
+		}
+	]`
+)
+
+var (
+	gist = Gist{
+		ID:          "aa5a315d61ae9438b18d",
+		Description: "Hello World Examples",
+		Public:      true,
+		Files: map[string]GistFile{
+			"hello_world.rb": {
+				Filename: "hello_world.rb",
+				Content:  "class HelloWorld\nend",
+				Language: "Ruby",
+				RawURL:   "https://gist.githubusercontent.com/octocat/6cad326836d38bd3a7ae/raw/db9c55113504e46fa076e7df3a04ce592e2e86d8/hello_world.rb",
+				Size:     167,
+			},
+		},
+		HTMLURL:   "https://gist.github.com/aa5a315d61ae9438b18d",
+		CreatedAt: parseGitHubTime("2020-10-20T20:00:00Z"),
+	}
+)
+
+func TestGistsService_Get(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *GistsService
+		ctx              context.Context
+		id               string
+		expectedGist     *Gist
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &GistsService{
+				client: c,
+			},
+			ctx:           nil,
+			id:            "aa5a315d61ae9438b18d",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/gists/aa5a315d61ae9438b18d", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &GistsService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			id:            "aa5a315d61ae9438b18d",
+			expectedError: `GET /gists/aa5a315d61ae9438b18d: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/gists/aa5a315d61ae9438b18d", 200, http.Header{}, `{`},
+			},
+			s: &GistsService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			id:            "aa5a315d61ae9438b18d",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/gists/aa5a315d61ae9438b18d", 200, header, gistBody},
+			},
+			s: &GistsService{
+				client: c,
+			},
+			ctx:          context.Background(),
+			id:           "aa5a315d61ae9438b18d",
+			expectedGist: &gist,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			gist, resp, err := tc.s.Get(tc.ctx, tc.id)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, gist)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedGist, gist)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestGistsService_List(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *GistsService
+		ctx              context.Context
+		pageSize         int
+		pageNo           int
+		expectedGists    []Gist
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &GistsService{
+				client: c,
+			},
+			ctx:           nil,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/gists", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &GistsService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /gists: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/gists", 200, http.Header{}, `[`},
+			},
+			s: &GistsService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/gists", 200, header, gistsBody},
+			},
+			s: &GistsService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedGists: []Gist{gist},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			gists, resp, err := tc.s.List(tc.ctx, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, gists)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedGists, gists)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestGistParams_Validate(t *testing.T) {
+	tests := []struct {
+		name          string
+		p             GistParams
+		expectedError string
+	}{
+		{
+			name:          "MissingFiles",
+			p:             GistParams{Description: "Hello World Examples"},
+			expectedError: `invalid gist params: at least one file is required`,
+		},
+		{
+			name: "OK",
+			p:    GistParams{Files: map[string]string{"hello_world.rb": "class HelloWorld\nend"}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.p.Validate()
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGistsService_Create(t *testing.T) {
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		params        GistParams
+		statusCode    int
+		respBody      string
+		expectedGist  *Gist
+		expectedError string
+	}{
+		{
+			name:          "InvalidParams",
+			ctx:           nil,
+			params:        GistParams{},
+			expectedError: `invalid gist params: at least one file is required`,
+		},
+		{
+			name: "NilContext",
+			ctx:  nil,
+			params: GistParams{
+				Files: map[string]string{
+					"hello_world.rb": "class HelloWorld\nend",
+				},
+			},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			ctx:  context.Background(),
+			params: GistParams{
+				Description: "Hello World Examples",
+				Public:      true,
+				Files: map[string]string{
+					"hello_world.rb": "class HelloWorld\nend",
+				},
+			},
+			statusCode:    401,
+			respBody:      `{"message": "Bad credentials"}`,
+			expectedError: `POST /gists: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			ctx:  context.Background(),
+			params: GistParams{
+				Description: "Hello World Examples",
+				Public:      true,
+				Files: map[string]string{
+					"hello_world.rb": "class HelloWorld\nend",
+				},
+			},
+			statusCode:    201,
+			respBody:      `{`,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			ctx:  context.Background(),
+			params: GistParams{
+				Description: "Hello World Examples",
+				Public:      true,
+				Files: map[string]string{
+					"hello_world.rb": "class HelloWorld\nend",
+				},
+			},
+			statusCode:   201,
+			respBody:     gistBody,
+			expectedGist: &gist,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "POST", r.Method)
+				assert.Equal(t, "/gists", r.URL.Path)
+
+				b, _ := ioutil.ReadAll(r.Body)
+				assert.JSONEq(t, `{
+					"description": "Hello World Examples",
+					"public": true,
+					"files": {
+						"hello_world.rb": {
+							"content": "class HelloWorld\nend"
+						}
+					}
+				}`, string(b))
+
+				for k, vals := range header {
+					w.Header()[k] = vals
+				}
+				w.WriteHeader(tc.statusCode)
+				_, _ = io.WriteString(w, tc.respBody)
+			}))
+			defer ts.Close()
+
+			c := &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+			}
+			c.apiURL, _ = url.Parse(ts.URL)
+
+			s := &GistsService{
+				client: c,
+			}
+
+			gist, resp, err := s.Create(tc.ctx, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, gist)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedGist, gist)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, expectedRate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestGistsService_Delete(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *GistsService
+		ctx              context.Context
+		id               string
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &GistsService{
+				client: c,
+			},
+			ctx:           nil,
+			id:            "aa5a315d61ae9438b18d",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/gists/aa5a315d61ae9438b18d", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &GistsService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			id:            "aa5a315d61ae9438b18d",
+			expectedError: `DELETE /gists/aa5a315d61ae9438b18d: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/gists/aa5a315d61ae9438b18d", 204, header, ``},
+			},
+			s: &GistsService{
+				client: c,
+			},
+			ctx: context.Background(),
+			id:  "aa5a315d61ae9438b18d",
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.Delete(tc.ctx, tc.id)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
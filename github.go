@@ -1,6 +1,8 @@
 package github
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/url"
 	"regexp"
@@ -126,6 +128,47 @@ func (e Epoch) String() string {
 	return e.Time().Format("15:04:05")
 }
 
+// MarshalJSON implements the json.Marshaler interface.
+// An epoch timestamp is encoded as the number of seconds since the Unix epoch.
+func (e Epoch) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatInt(int64(e), 10)), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// An epoch timestamp can be decoded from either a number of seconds or an RFC3339 string.
+func (e *Epoch) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	if len(b) > 0 && b[0] != '"' {
+		var i64 int64
+		if err := json.Unmarshal(b, &i64); err != nil {
+			return fmt.Errorf("invalid epoch: %s", b)
+		}
+		*e = Epoch(i64)
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	if i64, err := strconv.ParseInt(s, 10, 64); err == nil {
+		*e = Epoch(i64)
+		return nil
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return fmt.Errorf("invalid epoch: %s", b)
+	}
+	*e = Epoch(t.Unix())
+
+	return nil
+}
+
 // Rate represents the rate limit status for the authenticated user.
 type Rate struct {
 	// The number of requests per hour.
@@ -141,6 +184,16 @@ type Rate struct {
 	Reset Epoch `json:"reset"`
 }
 
+// ResetIn returns the duration until the current rate resets.
+// It returns zero if the reset time has already passed.
+func (r Rate) ResetIn() time.Duration {
+	if d := time.Until(r.Reset.Time()); d > 0 {
+		return d
+	}
+
+	return 0
+}
+
 // Response represents an HTTP response for GitHub API v3.
 type Response struct {
 	*http.Response
@@ -1,6 +1,7 @@
 package github
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/url"
 	"testing"
@@ -30,6 +31,137 @@ func TestEpoch(t *testing.T) {
 	}
 }
 
+func TestEpoch_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name         string
+		e            Epoch
+		expectedJSON string
+	}{
+		{
+			name:         "OK",
+			e:            Epoch(1605064490),
+			expectedJSON: `1605064490`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			b, err := json.Marshal(tc.e)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedJSON, string(b))
+		})
+	}
+}
+
+func TestEpoch_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name          string
+		data          string
+		expectedEpoch Epoch
+		expectedError string
+	}{
+		{
+			name:          "Null",
+			data:          `null`,
+			expectedEpoch: Epoch(0),
+		},
+		{
+			name:          "Number",
+			data:          `1605064490`,
+			expectedEpoch: Epoch(1605064490),
+		},
+		{
+			name:          "NumericString",
+			data:          `"1605064490"`,
+			expectedEpoch: Epoch(1605064490),
+		},
+		{
+			name:          "RFC3339String",
+			data:          `"2020-11-11T03:14:50Z"`,
+			expectedEpoch: Epoch(1605064490),
+		},
+		{
+			name:          "InvalidNumber",
+			data:          `1605064490.5`,
+			expectedError: `invalid epoch: 1605064490.5`,
+		},
+		{
+			name:          "InvalidString",
+			data:          `"tomorrow"`,
+			expectedError: `invalid epoch: "tomorrow"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var e Epoch
+			err := e.UnmarshalJSON([]byte(tc.data))
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedEpoch, e)
+			}
+		})
+	}
+}
+
+func TestRate_JSON(t *testing.T) {
+	r := Rate{
+		Limit:     5000,
+		Used:      10,
+		Remaining: 4990,
+		Reset:     Epoch(1605064490),
+	}
+
+	b, err := json.Marshal(r)
+	assert.NoError(t, err)
+
+	var decoded Rate
+	assert.NoError(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, r, decoded)
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"limit": 5000, "reset": "2020-11-11T03:14:50Z"}`), &decoded))
+	assert.Equal(t, Epoch(1605064490), decoded.Reset)
+}
+
+func TestRate_ResetIn(t *testing.T) {
+	tests := []struct {
+		name        string
+		r           Rate
+		expectedMin time.Duration
+		expectedMax time.Duration
+	}{
+		{
+			name: "Past",
+			r: Rate{
+				Reset: Epoch(time.Now().Add(-time.Minute).Unix()),
+			},
+			expectedMin: 0,
+			expectedMax: 0,
+		},
+		{
+			name: "Future",
+			r: Rate{
+				Reset: Epoch(time.Now().Add(time.Hour).Unix()),
+			},
+			expectedMin: 59 * time.Minute,
+			expectedMax: time.Hour,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			d := tc.r.ResetIn()
+
+			assert.GreaterOrEqual(t, int64(d), int64(tc.expectedMin))
+			assert.LessOrEqual(t, int64(d), int64(tc.expectedMax))
+		})
+	}
+}
+
 func TestResponse(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -1,6 +1,7 @@
 package github
 
 import (
+	"bytes"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -52,3 +53,54 @@ func newHTTPTestServer(mocks ...MockResponse) *httptest.Server {
 
 	return httptest.NewServer(r)
 }
+
+type mapCache map[string]*CachedResponse
+
+func (c mapCache) Get(url string) (*CachedResponse, bool) {
+	resp, ok := c[url]
+	return resp, ok
+}
+
+func (c mapCache) Set(url string, resp *CachedResponse) {
+	c[url] = resp
+}
+
+type observation struct {
+	group   string
+	method  string
+	path    string
+	status  int
+	latency time.Duration
+}
+
+type mockObserver struct {
+	observations []observation
+}
+
+func (o *mockObserver) ObserveRequest(group, method, path string, status int, latency time.Duration) {
+	o.observations = append(o.observations, observation{group, method, path, status, latency})
+}
+
+type errorWriter struct {
+	err error
+}
+
+func (w *errorWriter) Write([]byte) (int, error) {
+	return 0, w.err
+}
+
+// flushWriter is a buffer that records what has been written at each flush.
+type flushWriter struct {
+	bytes.Buffer
+	flushed []string
+	err     error
+}
+
+func (w *flushWriter) Flush() error {
+	if w.err != nil {
+		return w.err
+	}
+
+	w.flushed = append(w.flushed, w.String())
+	return nil
+}
@@ -0,0 +1,96 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// NotificationsService provides GitHub APIs for notifications.
+// Notifications require the access token to have the notifications or repo scope (see EnsureScopes).
+// See https://docs.github.com/en/rest/reference/activity#notifications
+type NotificationsService struct {
+	client *Client
+}
+
+type (
+	// NotificationSubject is the issue, pull request, commit, or release a notification is about.
+	NotificationSubject struct {
+		Title string `json:"title"`
+		Type  string `json:"type"`
+		URL   string `json:"url"`
+	}
+
+	// Notification is a GitHub notification (thread) object.
+	// Reason is why the authenticated user is receiving the notification (e.g. assign, mention, review_requested).
+	Notification struct {
+		ID         string              `json:"id"`
+		Reason     string              `json:"reason"`
+		Unread     bool                `json:"unread"`
+		Repository Repository          `json:"repository"`
+		Subject    NotificationSubject `json:"subject"`
+		UpdatedAt  time.Time           `json:"updated_at"`
+	}
+)
+
+// NotificationsParams are optional parameters for List.
+type NotificationsParams struct {
+	// All includes notifications already marked as read.
+	All bool
+	// Participating restricts the results to notifications in which the user is directly participating or mentioned.
+	Participating bool
+	// Since restricts the results to notifications updated after the given time.
+	Since time.Time
+}
+
+// List retrieves all notifications for the authenticated user page by page.
+// By default, only unread notifications are returned.
+// See https://docs.github.com/rest/reference/activity#list-notifications-for-the-authenticated-user
+func (s *NotificationsService) List(ctx context.Context, pageSize, pageNo int, params NotificationsParams) ([]Notification, *Response, error) {
+	req, err := s.client.NewPageRequest(ctx, "GET", "/notifications", pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := req.URL.Query()
+
+	if params.All {
+		q.Add("all", "true")
+	}
+
+	if params.Participating {
+		q.Add("participating", "true")
+	}
+
+	if !params.Since.IsZero() {
+		q.Add("since", params.Since.Format(time.RFC3339))
+	}
+
+	req.URL.RawQuery = q.Encode()
+
+	notifications := []Notification{}
+
+	resp, err := s.client.Do(req, &notifications)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return notifications, resp, nil
+}
+
+// MarkAsRead marks a notification thread as read.
+// See https://docs.github.com/rest/reference/activity#mark-a-thread-as-read
+func (s *NotificationsService) MarkAsRead(ctx context.Context, threadID string) (*Response, error) {
+	url := fmt.Sprintf("/notifications/threads/%s", threadID)
+	req, err := s.client.NewRequest(ctx, "PATCH", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
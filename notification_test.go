@@ -0,0 +1,291 @@
+package github
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	notificationsBody = `[
+		{
+			"id": "1",
+			"repository": {
+				"id": 1296269,
+				"name": "Hello-World",
+				"full_name": "octocat/Hello-World"
+			},
+			"subject": {
+				"title": "Greetings",
+				"url": "https://api.github.com/repos/octocat/Hello-World/issues/123",
+				"type": "Issue"
+			},
+			"reason": "subscribed",
+			"unread": true,
+			"updated_at": "2020-10-20T20:00:00Z"
+		}
+	]`
+)
+
+var (
+	notification = Notification{
+		ID:     "1",
+		Reason: "subscribed",
+		Unread: true,
+		Repository: Repository{
+			ID:       1296269,
+			Name:     "Hello-World",
+			FullName: "octocat/Hello-World",
+		},
+		Subject: NotificationSubject{
+			Title: "Greetings",
+			Type:  "Issue",
+			URL:   "https://api.github.com/repos/octocat/Hello-World/issues/123",
+		},
+		UpdatedAt: parseGitHubTime("2020-10-20T20:00:00Z"),
+	}
+)
+
+func TestNotificationsService_List(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name                  string
+		mockResponses         []MockResponse
+		s                     *NotificationsService
+		ctx                   context.Context
+		pageSize              int
+		pageNo                int
+		params                NotificationsParams
+		expectedNotifications []Notification
+		expectedResponse      *Response
+		expectedError         string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &NotificationsService{
+				client: c,
+			},
+			ctx:           nil,
+			pageSize:      10,
+			pageNo:        1,
+			params:        NotificationsParams{},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/notifications", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &NotificationsService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			params:        NotificationsParams{},
+			expectedError: `GET /notifications: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/notifications", 200, http.Header{}, `[`},
+			},
+			s: &NotificationsService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			params:        NotificationsParams{},
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/notifications", 200, header, notificationsBody},
+			},
+			s: &NotificationsService{
+				client: c,
+			},
+			ctx:                   context.Background(),
+			pageSize:              10,
+			pageNo:                1,
+			params:                NotificationsParams{},
+			expectedNotifications: []Notification{notification},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			notifications, resp, err := tc.s.List(tc.ctx, tc.pageSize, tc.pageNo, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, notifications)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedNotifications, notifications)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestNotificationsService_List_Query(t *testing.T) {
+	tests := []struct {
+		name          string
+		params        NotificationsParams
+		expectedQuery url.Values
+	}{
+		{
+			name:   "NoParams",
+			params: NotificationsParams{},
+			expectedQuery: url.Values{
+				"per_page": {"10"},
+				"page":     {"1"},
+			},
+		},
+		{
+			name: "AllParams",
+			params: NotificationsParams{
+				All:           true,
+				Participating: true,
+				Since:         parseGitHubTime("2020-10-01T00:00:00Z"),
+			},
+			expectedQuery: url.Values{
+				"per_page":      {"10"},
+				"page":          {"1"},
+				"all":           {"true"},
+				"participating": {"true"},
+				"since":         {"2020-10-01T00:00:00Z"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var query url.Values
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query = r.URL.Query()
+				_, _ = io.WriteString(w, `[]`)
+			}))
+			defer ts.Close()
+
+			c := &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+			}
+			c.apiURL, _ = url.Parse(ts.URL)
+
+			s := &NotificationsService{
+				client: c,
+			}
+
+			notifications, _, err := s.List(context.Background(), 10, 1, tc.params)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []Notification{}, notifications)
+			assert.Equal(t, tc.expectedQuery, query)
+		})
+	}
+}
+
+func TestNotificationsService_MarkAsRead(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *NotificationsService
+		ctx              context.Context
+		threadID         string
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &NotificationsService{
+				client: c,
+			},
+			ctx:           nil,
+			threadID:      "1",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PATCH", "/notifications/threads/1", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &NotificationsService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			threadID:      "1",
+			expectedError: `PATCH /notifications/threads/1: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PATCH", "/notifications/threads/1", 205, header, ``},
+			},
+			s: &NotificationsService{
+				client: c,
+			},
+			ctx:      context.Background(),
+			threadID: "1",
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.MarkAsRead(tc.ctx, tc.threadID)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// OrgsService provides GitHub APIs for organizations.
+// See https://docs.github.com/en/rest/reference/orgs
+type OrgsService struct {
+	client *Client
+}
+
+// Organization is a GitHub organization object.
+type Organization struct {
+	ID          int    `json:"id"`
+	Login       string `json:"login"`
+	Description string `json:"description"`
+	URL         string `json:"url"`
+	AvatarURL   string `json:"avatar_url"`
+}
+
+// Membership is a GitHub organization membership object.
+// State is either active or pending, and Role is either admin or member.
+type Membership struct {
+	State        string       `json:"state"`
+	Role         string       `json:"role"`
+	User         User         `json:"user"`
+	Organization Organization `json:"organization"`
+}
+
+// Team is a GitHub team object.
+// Privacy is either secret or closed, and Permission is the default permission of the team on the organization repositories.
+type Team struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Slug        string `json:"slug"`
+	Description string `json:"description"`
+	Privacy     string `json:"privacy"`
+	Permission  string `json:"permission"`
+}
+
+// Repos retrieves all repositories for a given organization page by page.
+// See https://docs.github.com/rest/reference/repos#list-organization-repositories
+func (s *OrgsService) Repos(ctx context.Context, org string, pageSize, pageNo int) ([]Repository, *Response, error) {
+	url := fmt.Sprintf("/orgs/%s/repos", org)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	repos := []Repository{}
+
+	resp, err := s.client.Do(req, &repos)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return repos, resp, nil
+}
+
+// ForEachRepo pages through all repositories of a given organization and calls fn with a RepoService for each one.
+// It stops and returns the first error returned by either the API or fn.
+// The context is checked between repositories, so a cancellation stops the iteration.
+func (s *OrgsService) ForEachRepo(ctx context.Context, org string, fn func(*RepoService) error) error {
+	for pageNo := 1; pageNo > 0; {
+		repos, resp, err := s.Repos(ctx, org, 100, pageNo)
+		if err != nil {
+			return err
+		}
+
+		for _, r := range repos {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			if err := fn(s.client.Repo(r.Owner.Login, r.Name)); err != nil {
+				return err
+			}
+		}
+
+		pageNo = resp.Pages.Next
+	}
+
+	return nil
+}
+
+// Teams retrieves all teams for a given organization page by page.
+// Only the teams visible to the authenticated user are returned.
+// See https://docs.github.com/rest/reference/teams#list-teams
+func (s *OrgsService) Teams(ctx context.Context, org string, pageSize, pageNo int) ([]Team, *Response, error) {
+	url := fmt.Sprintf("/orgs/%s/teams", org)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	teams := []Team{}
+
+	resp, err := s.client.Do(req, &teams)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return teams, resp, nil
+}
+
+// TeamMembers retrieves all members of a given team page by page.
+// Members of child teams are included as well.
+// See https://docs.github.com/rest/reference/teams#list-team-members-legacy
+func (s *OrgsService) TeamMembers(ctx context.Context, teamID, pageSize, pageNo int) ([]User, *Response, error) {
+	url := fmt.Sprintf("/teams/%d/members", teamID)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	members := []User{}
+
+	resp, err := s.client.Do(req, &members)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return members, resp, nil
+}
+
+// Membership retrieves the organization membership of a given user.
+// See https://docs.github.com/rest/reference/orgs#get-organization-membership-for-a-user
+func (s *OrgsService) Membership(ctx context.Context, org, username string) (*Membership, *Response, error) {
+	url := fmt.Sprintf("/orgs/%s/memberships/%s", org, username)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	membership := new(Membership)
+
+	resp, err := s.client.Do(req, membership)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return membership, resp, nil
+}
+
+// SetMembership adds a user to a given organization or updates the role of an existing member.
+// role is either admin or member (default is member).
+// A user who is not already a member is invited, and the membership remains pending until the invitation is accepted.
+// See https://docs.github.com/rest/reference/orgs#set-organization-membership-for-a-user
+func (s *OrgsService) SetMembership(ctx context.Context, org, username, role string) (*Membership, *Response, error) {
+	body := struct {
+		Role string `json:"role,omitempty"`
+	}{
+		Role: role,
+	}
+
+	url := fmt.Sprintf("/orgs/%s/memberships/%s", org, username)
+	req, err := s.client.NewRequest(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	membership := new(Membership)
+
+	resp, err := s.client.Do(req, membership)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return membership, resp, nil
+}
+
+// RemoveMembership removes a user from a given organization or cancels a pending invitation.
+// See https://docs.github.com/rest/reference/orgs#remove-organization-membership-for-a-user
+func (s *OrgsService) RemoveMembership(ctx context.Context, org, username string) (*Response, error) {
+	url := fmt.Sprintf("/orgs/%s/memberships/%s", org, username)
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,797 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	orgReposBody = `[
+		{
+			"id": 1296269,
+			"name": "Hello-World",
+			"full_name": "octocat/Hello-World",
+			"owner": {
+				"login": "octocat",
+				"id": 1,
+				"type": "User"
+			},
+			"private": false,
+			"description": "This your first repo!",
+			"fork": false,
+			"default_branch": "main",
+			"topics": [
+				"octocat",
+				"api"
+			],
+			"archived": false,
+			"disabled": false,
+			"visibility": "public",
+			"permissions": {
+				"admin": false,
+				"maintain": false,
+				"push": true,
+				"triage": true,
+				"pull": true
+			},
+			"pushed_at": "2020-10-31T14:00:00Z",
+			"created_at": "2020-01-20T09:00:00Z",
+			"updated_at": "2020-10-31T14:00:00Z"
+		}
+	]`
+
+	teamsBody = `[
+		{
+			"id": 1,
+			"name": "Justice League",
+			"slug": "justice-league",
+			"description": "A great team.",
+			"privacy": "closed",
+			"permission": "admin"
+		}
+	]`
+
+	teamMembersBody = `[
+		{
+			"login": "octocat",
+			"id": 1,
+			"url": "https://api.github.com/users/octocat",
+			"html_url": "https://github.com/octocat",
+			"type": "User",
+			"site_admin": false
+		}
+	]`
+
+	membershipBody = `{
+		"state": "active",
+		"role": "admin",
+		"user": {
+			"login": "octocat",
+			"id": 1,
+			"type": "User"
+		},
+		"organization": {
+			"login": "github",
+			"id": 2,
+			"url": "https://api.github.com/orgs/github",
+			"description": "A great organization"
+		}
+	}`
+)
+
+var (
+	team = Team{
+		ID:          1,
+		Name:        "Justice League",
+		Slug:        "justice-league",
+		Description: "A great team.",
+		Privacy:     "closed",
+		Permission:  "admin",
+	}
+
+	membership = Membership{
+		State: "active",
+		Role:  "admin",
+		User: User{
+			ID:    1,
+			Login: "octocat",
+			Type:  "User",
+		},
+		Organization: Organization{
+			ID:          2,
+			Login:       "github",
+			Description: "A great organization",
+			URL:         "https://api.github.com/orgs/github",
+		},
+	}
+)
+
+func TestOrgsService_Repos(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *OrgsService
+		ctx              context.Context
+		org              string
+		pageSize         int
+		pageNo           int
+		expectedRepos    []Repository
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &OrgsService{
+				client: c,
+			},
+			ctx:           nil,
+			org:           "octocat",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octocat/repos", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &OrgsService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			org:           "octocat",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /orgs/octocat/repos: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octocat/repos", 200, http.Header{}, `[`},
+			},
+			s: &OrgsService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			org:           "octocat",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octocat/repos", 200, header, orgReposBody},
+			},
+			s: &OrgsService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			org:           "octocat",
+			pageSize:      10,
+			pageNo:        1,
+			expectedRepos: []Repository{repository},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			repos, resp, err := tc.s.Repos(tc.ctx, tc.org, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, repos)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRepos, repos)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestOrgsService_ForEachRepo(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name          string
+		mockResponses []MockResponse
+		s             *OrgsService
+		ctx           context.Context
+		org           string
+		fn            func(*RepoService) error
+		expectedRepos []string
+		expectedError string
+	}{
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octocat/repos", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &OrgsService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			org:           "octocat",
+			expectedError: `GET /orgs/octocat/repos: 401 Bad credentials`,
+		},
+		{
+			name: "FuncError",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octocat/repos", 200, http.Header{}, orgReposBody},
+			},
+			s: &OrgsService{
+				client: c,
+			},
+			ctx: context.Background(),
+			org: "octocat",
+			fn: func(*RepoService) error {
+				return errors.New("error on repo")
+			},
+			expectedError: `error on repo`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octocat/repos", 200, http.Header{}, orgReposBody},
+			},
+			s: &OrgsService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			org:           "octocat",
+			expectedRepos: []string{"octocat/Hello-World"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			var repos []string
+			fn := tc.fn
+			if fn == nil {
+				fn = func(s *RepoService) error {
+					repos = append(repos, s.owner+"/"+s.repo)
+					return nil
+				}
+			}
+
+			err := tc.s.ForEachRepo(tc.ctx, tc.org, fn)
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRepos, repos)
+			}
+		})
+	}
+}
+
+func TestOrgsService_Teams(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *OrgsService
+		ctx              context.Context
+		org              string
+		pageSize         int
+		pageNo           int
+		expectedTeams    []Team
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &OrgsService{
+				client: c,
+			},
+			ctx:           nil,
+			org:           "octocat",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octocat/teams", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &OrgsService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			org:           "octocat",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /orgs/octocat/teams: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octocat/teams", 200, http.Header{}, `[`},
+			},
+			s: &OrgsService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			org:           "octocat",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octocat/teams", 200, header, teamsBody},
+			},
+			s: &OrgsService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			org:           "octocat",
+			pageSize:      10,
+			pageNo:        1,
+			expectedTeams: []Team{team},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			teams, resp, err := tc.s.Teams(tc.ctx, tc.org, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, teams)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedTeams, teams)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestOrgsService_TeamMembers(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *OrgsService
+		ctx              context.Context
+		teamID           int
+		pageSize         int
+		pageNo           int
+		expectedMembers  []User
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &OrgsService{
+				client: c,
+			},
+			ctx:           nil,
+			teamID:        1,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/teams/1/members", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &OrgsService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			teamID:        1,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /teams/1/members: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/teams/1/members", 200, http.Header{}, `[`},
+			},
+			s: &OrgsService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			teamID:        1,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/teams/1/members", 200, header, teamMembersBody},
+			},
+			s: &OrgsService{
+				client: c,
+			},
+			ctx:      context.Background(),
+			teamID:   1,
+			pageSize: 10,
+			pageNo:   1,
+			expectedMembers: []User{
+				{
+					ID:      1,
+					Login:   "octocat",
+					Type:    "User",
+					URL:     "https://api.github.com/users/octocat",
+					HTMLURL: "https://github.com/octocat",
+				},
+			},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			members, resp, err := tc.s.TeamMembers(tc.ctx, tc.teamID, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, members)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedMembers, members)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestOrgsService_Membership(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *OrgsService
+		ctx                context.Context
+		org                string
+		username           string
+		expectedMembership *Membership
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &OrgsService{
+				client: c,
+			},
+			ctx:           nil,
+			org:           "github",
+			username:      "octocat",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/github/memberships/octocat", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &OrgsService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			org:           "github",
+			username:      "octocat",
+			expectedError: `GET /orgs/github/memberships/octocat: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/github/memberships/octocat", 200, http.Header{}, `{`},
+			},
+			s: &OrgsService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			org:           "github",
+			username:      "octocat",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/github/memberships/octocat", 200, header, membershipBody},
+			},
+			s: &OrgsService{
+				client: c,
+			},
+			ctx:                context.Background(),
+			org:                "github",
+			username:           "octocat",
+			expectedMembership: &membership,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			membership, resp, err := tc.s.Membership(tc.ctx, tc.org, tc.username)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, membership)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedMembership, membership)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestOrgsService_SetMembership(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *OrgsService
+		ctx                context.Context
+		org                string
+		username           string
+		role               string
+		expectedMembership *Membership
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &OrgsService{
+				client: c,
+			},
+			ctx:           nil,
+			org:           "github",
+			username:      "octocat",
+			role:          "admin",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PUT", "/orgs/github/memberships/octocat", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &OrgsService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			org:           "github",
+			username:      "octocat",
+			role:          "admin",
+			expectedError: `PUT /orgs/github/memberships/octocat: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"PUT", "/orgs/github/memberships/octocat", 200, http.Header{}, `{`},
+			},
+			s: &OrgsService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			org:           "github",
+			username:      "octocat",
+			role:          "admin",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PUT", "/orgs/github/memberships/octocat", 200, header, membershipBody},
+			},
+			s: &OrgsService{
+				client: c,
+			},
+			ctx:                context.Background(),
+			org:                "github",
+			username:           "octocat",
+			role:               "admin",
+			expectedMembership: &membership,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			membership, resp, err := tc.s.SetMembership(tc.ctx, tc.org, tc.username, tc.role)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, membership)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedMembership, membership)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestOrgsService_RemoveMembership(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *OrgsService
+		ctx              context.Context
+		org              string
+		username         string
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &OrgsService{
+				client: c,
+			},
+			ctx:           nil,
+			org:           "github",
+			username:      "octocat",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/orgs/github/memberships/octocat", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &OrgsService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			org:           "github",
+			username:      "octocat",
+			expectedError: `DELETE /orgs/github/memberships/octocat: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/orgs/github/memberships/octocat", 204, header, ``},
+			},
+			s: &OrgsService{
+				client: c,
+			},
+			ctx:      context.Background(),
+			org:      "github",
+			username: "octocat",
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.RemoveMembership(tc.ctx, tc.org, tc.username)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
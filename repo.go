@@ -1,10 +1,18 @@
 package github
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"net/url"
 	"path/filepath"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -17,22 +25,56 @@ type RepoService struct {
 
 // Repository is a GitHub repository object.
 type Repository struct {
-	ID            int       `json:"id"`
-	Name          string    `json:"name"`
-	FullName      string    `json:"full_name"`
-	Description   string    `json:"description"`
-	Topics        []string  `json:"topics"`
-	Private       bool      `json:"private"`
-	Fork          bool      `json:"fork"`
-	Archived      bool      `json:"archived"`
-	Disabled      bool      `json:"disabled"`
-	DefaultBranch string    `json:"default_branch"`
-	Owner         User      `json:"owner"`
-	URL           string    `json:"url"`
-	HTMLURL       string    `json:"html_url"`
-	CreatedAt     time.Time `json:"created_at"`
-	UpdatedAt     time.Time `json:"updated_at"`
-	PushedAt      time.Time `json:"pushed_at"`
+	ID            int              `json:"id"`
+	Name          string           `json:"name"`
+	FullName      string           `json:"full_name"`
+	Description   string           `json:"description"`
+	Topics        []string         `json:"topics"`
+	Private       bool             `json:"private"`
+	Fork          bool             `json:"fork"`
+	Archived      bool             `json:"archived"`
+	Disabled      bool             `json:"disabled"`
+	DefaultBranch string           `json:"default_branch"`
+	HasIssues     bool             `json:"has_issues"`
+	HasProjects   bool             `json:"has_projects"`
+	HasWiki       bool             `json:"has_wiki"`
+	Owner         User             `json:"owner"`
+	Permissions   *RepoPermissions `json:"permissions"`
+	URL           string           `json:"url"`
+	HTMLURL       string           `json:"html_url"`
+	CreatedAt     time.Time        `json:"created_at"`
+	UpdatedAt     time.Time        `json:"updated_at"`
+	PushedAt      time.Time        `json:"pushed_at"`
+}
+
+// RepoPermissions represents the permissions of the authenticated user on a repository.
+type RepoPermissions struct {
+	Admin    bool `json:"admin"`
+	Maintain bool `json:"maintain"`
+	Push     bool `json:"push"`
+	Triage   bool `json:"triage"`
+	Pull     bool `json:"pull"`
+}
+
+// HighestPermission returns the highest permission the authenticated user has on the repository.
+// It returns PermissionNone if the repository object does not include any permissions.
+func (r Repository) HighestPermission() Permission {
+	switch p := r.Permissions; {
+	case p == nil:
+		return PermissionNone
+	case p.Admin:
+		return PermissionAdmin
+	case p.Maintain:
+		return PermissionMaintain
+	case p.Push:
+		return PermissionWrite
+	case p.Triage:
+		return PermissionTriage
+	case p.Pull:
+		return PermissionRead
+	default:
+		return PermissionNone
+	}
 }
 
 // Permission represents a GitHub repository permission.
@@ -54,6 +96,64 @@ const (
 	PermissionAdmin Permission = "admin"
 )
 
+// CollaboratorPermission is a GitHub repository permission object for a collaborator.
+// RoleName can be a custom repository role, whereas Permission is always one of the built-in permissions.
+type CollaboratorPermission struct {
+	Permission Permission `json:"permission"`
+	RoleName   string     `json:"role_name"`
+	User       User       `json:"user"`
+}
+
+// Contributor is a GitHub repository contributor object.
+type Contributor struct {
+	User
+	Contributions int `json:"contributions"`
+}
+
+// Invitation is a GitHub repository invitation object.
+type Invitation struct {
+	ID          int        `json:"id"`
+	Repository  Repository `json:"repository"`
+	Invitee     User       `json:"invitee"`
+	Inviter     User       `json:"inviter"`
+	Permissions string     `json:"permissions"`
+	Expired     bool       `json:"expired"`
+	URL         string     `json:"url"`
+	HTMLURL     string     `json:"html_url"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// RepoContent is a GitHub repository content object representing a file, directory, symlink, or submodule.
+type RepoContent struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Path        string `json:"path"`
+	SHA         string `json:"sha"`
+	Size        int    `json:"size"`
+	Encoding    string `json:"encoding"`
+	Content     string `json:"content"`
+	URL         string `json:"url"`
+	HTMLURL     string `json:"html_url"`
+	DownloadURL string `json:"download_url"`
+}
+
+// DecodedContent returns the decoded content of a file.
+func (c RepoContent) DecodedContent() ([]byte, error) {
+	switch c.Encoding {
+	case "":
+		return []byte(c.Content), nil
+	case "base64":
+		// GitHub wraps base64-encoded content into multiple lines
+		b, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(c.Content, "\n", ""))
+		if err != nil {
+			return nil, err
+		}
+		return b, nil
+	default:
+		return nil, fmt.Errorf("unsupported content encoding: %s", c.Encoding)
+	}
+}
+
 type (
 	// Hash is a GitHub hash object.
 	Hash struct {
@@ -89,6 +189,122 @@ type (
 	}
 )
 
+// Comparison is a GitHub comparison object between two commits.
+type Comparison struct {
+	Status          string     `json:"status"`
+	AheadBy         int        `json:"ahead_by"`
+	BehindBy        int        `json:"behind_by"`
+	TotalCommits    int        `json:"total_commits"`
+	BaseCommit      Commit     `json:"base_commit"`
+	MergeBaseCommit Commit     `json:"merge_base_commit"`
+	Commits         []Commit   `json:"commits"`
+	Files           []PullFile `json:"files"`
+	URL             string     `json:"url"`
+	HTMLURL         string     `json:"html_url"`
+	DiffURL         string     `json:"diff_url"`
+	PatchURL        string     `json:"patch_url"`
+}
+
+// Status is a GitHub commit status object.
+type Status struct {
+	ID          int       `json:"id"`
+	State       string    `json:"state"`
+	TargetURL   string    `json:"target_url"`
+	Description string    `json:"description"`
+	Context     string    `json:"context"`
+	Creator     User      `json:"creator"`
+	URL         string    `json:"url"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// Validate checks the state of a Status before sending it to GitHub.
+func (s Status) Validate() error {
+	switch s.State {
+	case "":
+		return errors.New("invalid status: state is required")
+	case "error", "failure", "pending", "success":
+		return nil
+	default:
+		return fmt.Errorf("invalid status: unknown state %q", s.State)
+	}
+}
+
+// CombinedStatus is a GitHub combined status object for a ref.
+type CombinedStatus struct {
+	State      string   `json:"state"`
+	TotalCount int      `json:"total_count"`
+	SHA        string   `json:"sha"`
+	Statuses   []Status `json:"statuses"`
+}
+
+// CheckRun is a GitHub check run object.
+type CheckRun struct {
+	ID          int        `json:"id"`
+	Name        string     `json:"name"`
+	Status      string     `json:"status"`
+	Conclusion  string     `json:"conclusion"`
+	HeadSHA     string     `json:"head_sha"`
+	HTMLURL     string     `json:"html_url"`
+	StartedAt   time.Time  `json:"started_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+}
+
+// RepoActivity is a GitHub repository activity object for a change to a ref (push, merge, force push, etc.).
+type RepoActivity struct {
+	ID           int       `json:"id"`
+	Ref          string    `json:"ref"`
+	Before       string    `json:"before"`
+	After        string    `json:"after"`
+	Timestamp    time.Time `json:"timestamp"`
+	ActivityType string    `json:"activity_type"`
+	Actor        User      `json:"actor"`
+}
+
+// WorkflowRun is a GitHub Actions workflow run object.
+type WorkflowRun struct {
+	ID         int       `json:"id"`
+	Name       string    `json:"name"`
+	WorkflowID int       `json:"workflow_id"`
+	RunNumber  int       `json:"run_number"`
+	Event      string    `json:"event"`
+	Status     string    `json:"status"`
+	Conclusion string    `json:"conclusion"`
+	HeadBranch string    `json:"head_branch"`
+	HeadSHA    string    `json:"head_sha"`
+	URL        string    `json:"url"`
+	HTMLURL    string    `json:"html_url"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// Webhook is a GitHub repository webhook object.
+type Webhook struct {
+	ID        int               `json:"id"`
+	Name      string            `json:"name"`
+	Active    bool              `json:"active"`
+	Events    []string          `json:"events"`
+	Config    map[string]string `json:"config"`
+	URL       string            `json:"url"`
+	CreatedAt time.Time         `json:"created_at"`
+	UpdatedAt time.Time         `json:"updated_at"`
+}
+
+// HookDelivery is a GitHub webhook delivery object.
+// RedeliveryOf is the ID of the original delivery when the delivery is a redelivery.
+type HookDelivery struct {
+	ID           int       `json:"id"`
+	GUID         string    `json:"guid"`
+	Event        string    `json:"event"`
+	Action       string    `json:"action"`
+	Status       string    `json:"status"`
+	StatusCode   int       `json:"status_code"`
+	Duration     float64   `json:"duration"`
+	Redelivery   bool      `json:"redelivery"`
+	RedeliveryOf int       `json:"redelivery_of"`
+	DeliveredAt  time.Time `json:"delivered_at"`
+}
+
 // Branch is a GitHub branch object.
 type Branch struct {
 	Name      string `json:"name"`
@@ -96,12 +312,97 @@ type Branch struct {
 	Commit    Commit `json:"commit"`
 }
 
+type (
+	// RequiredStatusChecks are the status checks that must pass before merging into a protected branch.
+	RequiredStatusChecks struct {
+		Strict   bool     `json:"strict"`
+		Contexts []string `json:"contexts"`
+	}
+
+	// RequiredPullRequestReviews are the reviews required before merging into a protected branch.
+	RequiredPullRequestReviews struct {
+		DismissStaleReviews          bool `json:"dismiss_stale_reviews"`
+		RequireCodeOwnerReviews      bool `json:"require_code_owner_reviews"`
+		RequiredApprovingReviewCount int  `json:"required_approving_review_count"`
+	}
+
+	// BranchRestrictions are the users and teams (by their logins and slugs) allowed to push to a protected branch.
+	BranchRestrictions struct {
+		Users []string `json:"users"`
+		Teams []string `json:"teams"`
+	}
+
+	// BranchProtectionRules is used for setting the protection rules of a branch.
+	// A nil rule disables the corresponding protection.
+	BranchProtectionRules struct {
+		RequiredStatusChecks       *RequiredStatusChecks       `json:"required_status_checks"`
+		EnforceAdmins              bool                        `json:"enforce_admins"`
+		RequiredPullRequestReviews *RequiredPullRequestReviews `json:"required_pull_request_reviews"`
+		Restrictions               *BranchRestrictions         `json:"restrictions"`
+	}
+
+	// ProtectionSetting is a GitHub branch protection setting that can be enabled or disabled.
+	ProtectionSetting struct {
+		Enabled bool `json:"enabled"`
+	}
+
+	// ProtectionTeam is a GitHub team allowed to push to a protected branch.
+	ProtectionTeam struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+		Slug string `json:"slug"`
+	}
+
+	// ProtectionRestrictions are the users and teams allowed to push to a protected branch.
+	ProtectionRestrictions struct {
+		Users []User           `json:"users"`
+		Teams []ProtectionTeam `json:"teams"`
+	}
+
+	// BranchProtection is a GitHub branch protection object.
+	// A nil field means the corresponding protection is disabled.
+	BranchProtection struct {
+		URL                        string                      `json:"url"`
+		RequiredStatusChecks       *RequiredStatusChecks       `json:"required_status_checks"`
+		EnforceAdmins              ProtectionSetting           `json:"enforce_admins"`
+		RequiredPullRequestReviews *RequiredPullRequestReviews `json:"required_pull_request_reviews"`
+		Restrictions               *ProtectionRestrictions     `json:"restrictions"`
+	}
+)
+
 // Tag is a GitHib tag object.
 type Tag struct {
 	Name   string `json:"name"`
 	Commit Hash   `json:"commit"`
 }
 
+// GitTag is a GitHub annotated tag object.
+type GitTag struct {
+	Tag     string    `json:"tag"`
+	SHA     string    `json:"sha"`
+	Message string    `json:"message"`
+	Tagger  Signature `json:"tagger"`
+	Object  Hash      `json:"object"`
+	URL     string    `json:"url"`
+}
+
+type (
+	// ReferenceObject is the Git object a reference points to.
+	// Type is either commit or tag (for an annotated tag).
+	ReferenceObject struct {
+		Type string `json:"type"`
+		SHA  string `json:"sha"`
+		URL  string `json:"url"`
+	}
+
+	// Reference is a GitHub Git reference object.
+	Reference struct {
+		Ref    string          `json:"ref"`
+		URL    string          `json:"url"`
+		Object ReferenceObject `json:"object"`
+	}
+)
+
 // Label is a GitHub label object.
 type Label struct {
 	ID          int    `json:"id"`
@@ -142,25 +443,47 @@ type (
 
 	// Issue is a GitHub issue object.
 	Issue struct {
-		ID        int        `json:"id"`
-		Number    int        `json:"number"`
-		State     string     `json:"state"`
-		Locked    bool       `json:"locked"`
-		Title     string     `json:"title"`
-		Body      string     `json:"body"`
-		User      User       `json:"user"`
-		Labels    []Label    `json:"labels"`
-		Milestone *Milestone `json:"milestone"`
-		URL       string     `json:"url"`
-		HTMLURL   string     `json:"html_url"`
-		LabelsURL string     `json:"labels_url"`
-		PullURLs  *PullURLs  `json:"pull_request"`
-		CreatedAt time.Time  `json:"created_at"`
-		UpdatedAt time.Time  `json:"updated_at"`
-		ClosedAt  *time.Time `json:"closed_at"`
+		ID          int        `json:"id"`
+		Number      int        `json:"number"`
+		State       string     `json:"state"`
+		StateReason string     `json:"state_reason"`
+		Locked      bool       `json:"locked"`
+		Title       string     `json:"title"`
+		Body        string     `json:"body"`
+		User        User       `json:"user"`
+		Labels      []Label    `json:"labels"`
+		Milestone   *Milestone `json:"milestone"`
+		URL         string     `json:"url"`
+		HTMLURL     string     `json:"html_url"`
+		LabelsURL   string     `json:"labels_url"`
+		PullURLs    *PullURLs  `json:"pull_request"`
+		CreatedAt   time.Time  `json:"created_at"`
+		UpdatedAt   time.Time  `json:"updated_at"`
+		ClosedAt    *time.Time `json:"closed_at"`
+	}
+
+	// IssueParams is used for updating a GitHub issue.
+	// Fields left empty are not changed.
+	IssueParams struct {
+		Title       string   `json:"title,omitempty"`
+		Body        string   `json:"body,omitempty"`
+		State       string   `json:"state,omitempty"`
+		StateReason string   `json:"state_reason,omitempty"`
+		Labels      []string `json:"labels,omitempty"`
+		Assignees   []string `json:"assignees,omitempty"`
 	}
 )
 
+// Validate checks the state reason of an IssueParams before sending it to GitHub.
+func (p IssueParams) Validate() error {
+	switch p.StateReason {
+	case "", "completed", "not_planned", "reopened":
+		return nil
+	default:
+		return fmt.Errorf("invalid issue params: unknown state_reason %q", p.StateReason)
+	}
+}
+
 type (
 	// PullBranch represents a base or head object in a Pull object.
 	PullBranch struct {
@@ -202,6 +525,31 @@ type (
 		ClosedAt       *time.Time `json:"closed_at"`
 		MergedAt       *time.Time `json:"merged_at"`
 	}
+
+	// PullFile is a GitHub pull request file object.
+	PullFile struct {
+		SHA         string `json:"sha"`
+		Filename    string `json:"filename"`
+		Status      string `json:"status"`
+		Additions   int    `json:"additions"`
+		Deletions   int    `json:"deletions"`
+		Changes     int    `json:"changes"`
+		Patch       string `json:"patch"`
+		BlobURL     string `json:"blob_url"`
+		RawURL      string `json:"raw_url"`
+		ContentsURL string `json:"contents_url"`
+	}
+
+	// Review is a GitHub pull request review object.
+	Review struct {
+		ID          int       `json:"id"`
+		User        User      `json:"user"`
+		Body        string    `json:"body"`
+		State       string    `json:"state"`
+		CommitID    string    `json:"commit_id"`
+		HTMLURL     string    `json:"html_url"`
+		SubmittedAt time.Time `json:"submitted_at"`
+	}
 )
 
 // Event is a GitHub event object.
@@ -215,6 +563,34 @@ type Event struct {
 	CreatedAt time.Time `json:"created_at"`
 }
 
+type (
+	// TimelineSource is the issue or pull request that cross-referenced an issue.
+	TimelineSource struct {
+		Type  string `json:"type"`
+		Issue Issue  `json:"issue"`
+	}
+
+	// TimelineRename is the change of an issue title.
+	TimelineRename struct {
+		From string `json:"from"`
+		To   string `json:"to"`
+	}
+
+	// TimelineEvent is a GitHub timeline event object for an issue or a pull request.
+	// Label is only set for labeled and unlabeled events,
+	// Source is only set for cross-referenced events,
+	// and Rename is only set for renamed events.
+	TimelineEvent struct {
+		ID        int             `json:"id"`
+		Event     string          `json:"event"`
+		Actor     User            `json:"actor"`
+		CreatedAt time.Time       `json:"created_at"`
+		Label     *Label          `json:"label"`
+		Source    *TimelineSource `json:"source"`
+		Rename    *TimelineRename `json:"rename"`
+	}
+)
+
 type (
 	// ReleaseParams is used for creating or updating a GitHub release.
 	ReleaseParams struct {
@@ -264,6 +640,94 @@ type (
 	}
 )
 
+// Validate checks the required fields of a ReleaseParams before sending it to GitHub.
+func (p ReleaseParams) Validate() error {
+	if p.TagName == "" {
+		return errors.New("invalid release params: tag_name is required")
+	}
+
+	return nil
+}
+
+type (
+	// DeploymentParams is used for creating a GitHub deployment.
+	DeploymentParams struct {
+		Ref         string `json:"ref"`
+		Task        string `json:"task,omitempty"`
+		Environment string `json:"environment,omitempty"`
+		// AutoMerge is always sent, so the default branch is not merged into the ref unless requested.
+		AutoMerge bool `json:"auto_merge"`
+		// RequiredContexts are the status contexts verified against the ref.
+		// If nil, all unique contexts are verified.
+		RequiredContexts []string               `json:"required_contexts,omitempty"`
+		Payload          map[string]interface{} `json:"payload,omitempty"`
+		Description      string                 `json:"description,omitempty"`
+	}
+
+	// Deployment is a GitHub deployment object.
+	Deployment struct {
+		ID          int       `json:"id"`
+		SHA         string    `json:"sha"`
+		Ref         string    `json:"ref"`
+		Task        string    `json:"task"`
+		Environment string    `json:"environment"`
+		Description string    `json:"description"`
+		Creator     User      `json:"creator"`
+		CreatedAt   time.Time `json:"created_at"`
+	}
+
+	// DeploymentStatus is a GitHub deployment status object.
+	// State is one of pending, in_progress, queued, success, failure, error, or inactive.
+	DeploymentStatus struct {
+		ID          int       `json:"id"`
+		State       string    `json:"state"`
+		Description string    `json:"description"`
+		Environment string    `json:"environment"`
+		TargetURL   string    `json:"target_url"`
+		LogURL      string    `json:"log_url"`
+		CreatedAt   time.Time `json:"created_at"`
+	}
+)
+
+// Validate checks the required fields of a DeploymentParams before sending it to GitHub.
+func (p DeploymentParams) Validate() error {
+	if p.Ref == "" {
+		return errors.New("invalid deployment params: ref is required")
+	}
+
+	return nil
+}
+
+// Validate checks the state of a DeploymentStatus before sending it to GitHub.
+func (s DeploymentStatus) Validate() error {
+	switch s.State {
+	case "":
+		return errors.New("invalid deployment status: state is required")
+	case "error", "failure", "inactive", "in_progress", "queued", "pending", "success":
+		return nil
+	default:
+		return fmt.Errorf("invalid deployment status: unknown state %q", s.State)
+	}
+}
+
+type (
+	// TrafficData is the number of views or clones of a repository in a timestamp.
+	TrafficData struct {
+		Timestamp time.Time `json:"timestamp"`
+		Count     int       `json:"count"`
+		Uniques   int       `json:"uniques"`
+	}
+
+	// Traffic is a GitHub repository traffic object.
+	// Views is only set for views and Clones is only set for clones.
+	Traffic struct {
+		Count   int           `json:"count"`
+		Uniques int           `json:"uniques"`
+		Views   []TrafficData `json:"views"`
+		Clones  []TrafficData `json:"clones"`
+	}
+)
+
 // Get retrieves a repository by its name.
 // See https://docs.github.com/rest/reference/repos#get-a-repository
 func (s *RepoService) Get(ctx context.Context) (*Repository, *Response, error) {
@@ -283,205 +747,1635 @@ func (s *RepoService) Get(ctx context.Context) (*Repository, *Response, error) {
 	return repository, resp, nil
 }
 
-// Permission returns the repository permission for a collaborator (user).
-// See https://docs.github.com/en/rest/reference/repos#get-repository-permissions-for-a-user
-func (s *RepoService) Permission(ctx context.Context, username string) (Permission, *Response, error) {
-	url := fmt.Sprintf("/repos/%s/%s/collaborators/%s/permission", s.owner, s.repo, username)
-	req, err := s.client.NewRequest(ctx, "GET", url, nil)
-	if err != nil {
-		return "", nil, err
-	}
+// SetIssuesEnabled enables or disables issues for a given repository.
+// See https://docs.github.com/rest/reference/repos#update-a-repository
+func (s *RepoService) SetIssuesEnabled(ctx context.Context, enabled bool) (*Repository, *Response, error) {
+	return s.update(ctx, map[string]bool{"has_issues": enabled})
+}
 
-	body := new(struct {
-		Permission Permission `json:"permission"`
-		User       User       `json:"user"`
-	})
+// SetWikiEnabled enables or disables the wiki for a given repository.
+// See https://docs.github.com/rest/reference/repos#update-a-repository
+func (s *RepoService) SetWikiEnabled(ctx context.Context, enabled bool) (*Repository, *Response, error) {
+	return s.update(ctx, map[string]bool{"has_wiki": enabled})
+}
 
-	resp, err := s.client.Do(req, body)
-	if err != nil {
-		return "", nil, err
+// SetProjectsEnabled enables or disables projects for a given repository.
+// See https://docs.github.com/rest/reference/repos#update-a-repository
+func (s *RepoService) SetProjectsEnabled(ctx context.Context, enabled bool) (*Repository, *Response, error) {
+	return s.update(ctx, map[string]bool{"has_projects": enabled})
+}
+
+// SetDefaultBranch changes the default branch for a given repository.
+// It first verifies the branch exists, so a missing branch results in a NotFoundError rather than a validation error.
+// See https://docs.github.com/rest/reference/repos#update-a-repository
+func (s *RepoService) SetDefaultBranch(ctx context.Context, branch string) (*Repository, *Response, error) {
+	if _, _, err := s.Branch(ctx, branch); err != nil {
+		return nil, nil, err
 	}
 
-	return body.Permission, resp, nil
+	return s.update(ctx, map[string]string{"default_branch": branch})
 }
 
-// Commit retrieves a commit for a given repository by its reference.
-// See https://docs.github.com/rest/reference/repos#get-a-commit
-func (s *RepoService) Commit(ctx context.Context, ref string) (*Commit, *Response, error) {
-	url := fmt.Sprintf("/repos/%s/%s/commits/%s", s.owner, s.repo, ref)
-	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+// update sends only the given fields, so other repository settings are not changed.
+func (s *RepoService) update(ctx context.Context, body interface{}) (*Repository, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s", s.owner, s.repo)
+	req, err := s.client.NewRequest(ctx, "PATCH", url, body)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	commit := new(Commit)
+	repository := new(Repository)
 
-	resp, err := s.client.Do(req, commit)
+	resp, err := s.client.Do(req, repository)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	return commit, resp, nil
+	return repository, resp, nil
 }
 
-// Commits retrieves all commits for a given repository page by page.
-// See https://docs.github.com/rest/reference/repos#list-commits
-func (s *RepoService) Commits(ctx context.Context, pageSize, pageNo int) ([]Commit, *Response, error) {
-	url := fmt.Sprintf("/repos/%s/%s/commits", s.owner, s.repo)
-	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+// Counts returns the number of open issues and open pull requests for a given repository.
+// It uses the search API to read only the total counts, which is much cheaper than paging through all items.
+// The returned Response is the one from the last search request.
+// See https://docs.github.com/rest/reference/search#search-issues-and-pull-requests
+func (s *RepoService) Counts(ctx context.Context) (int, int, *Response, error) {
+	openIssues, _, err := s.searchCount(ctx, fmt.Sprintf("repo:%s/%s is:issue is:open", s.owner, s.repo))
 	if err != nil {
-		return nil, nil, err
+		return 0, 0, nil, err
 	}
 
-	commits := []Commit{}
-
-	resp, err := s.client.Do(req, &commits)
+	openPulls, resp, err := s.searchCount(ctx, fmt.Sprintf("repo:%s/%s is:pr is:open", s.owner, s.repo))
 	if err != nil {
-		return nil, nil, err
+		return 0, 0, nil, err
 	}
 
-	return commits, resp, nil
+	return openIssues, openPulls, resp, nil
 }
 
-// Branch retrieves a branch for a given repository by its name.
-// See https://docs.github.com/rest/reference/repos#get-a-branch
-func (s *RepoService) Branch(ctx context.Context, name string) (*Branch, *Response, error) {
-	url := fmt.Sprintf("/repos/%s/%s/branches/%s", s.owner, s.repo, name)
-	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+func (s *RepoService) searchCount(ctx context.Context, query string) (int, *Response, error) {
+	req, err := s.client.NewPageRequest(ctx, "GET", "/search/issues", 1, 1, nil)
 	if err != nil {
-		return nil, nil, err
+		return 0, nil, err
 	}
 
-	branch := new(Branch)
+	q := req.URL.Query()
+	q.Add("q", query)
+	req.URL.RawQuery = q.Encode()
 
-	resp, err := s.client.Do(req, branch)
+	body := new(struct {
+		TotalCount int `json:"total_count"`
+	})
+
+	resp, err := s.client.Do(req, body)
 	if err != nil {
-		return nil, nil, err
+		return 0, nil, err
 	}
 
-	return branch, resp, nil
+	return body.TotalCount, resp, nil
 }
 
-// BranchProtection enables/disables a branch protection for administrator users.
-// See https://docs.github.com/rest/reference/repos#set-admin-branch-protection
-// See https://docs.github.com/rest/reference/repos#delete-admin-branch-protection
-func (s *RepoService) BranchProtection(ctx context.Context, branch string, enabled bool) (*Response, error) {
-	var method string
-	if enabled {
-		method = "POST"
-	} else {
-		method = "DELETE"
+// Permission returns the repository permission for a collaborator (user).
+// See https://docs.github.com/en/rest/reference/repos#get-repository-permissions-for-a-user
+func (s *RepoService) Permission(ctx context.Context, username string) (Permission, *Response, error) {
+	perm, resp, err := s.DetailedPermission(ctx, username)
+	if err != nil {
+		return "", nil, err
 	}
 
-	url := fmt.Sprintf("/repos/%s/%s/branches/%s/protection/enforce_admins", s.owner, s.repo, branch)
-	req, err := s.client.NewRequest(ctx, method, url, nil)
+	return perm.Permission, resp, nil
+}
+
+// DetailedPermission returns the repository permission and the role name for a collaborator (user).
+// Unlike Permission, it preserves custom repository roles which GitHub maps to a built-in permission.
+// See https://docs.github.com/en/rest/reference/repos#get-repository-permissions-for-a-user
+func (s *RepoService) DetailedPermission(ctx context.Context, username string) (*CollaboratorPermission, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/collaborators/%s/permission", s.owner, s.repo, username)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	resp, err := s.client.Do(req, nil)
+	perm := new(CollaboratorPermission)
+
+	resp, err := s.client.Do(req, perm)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp, nil
+	return perm, resp, nil
 }
 
-// Tags retrieves all tags for a given repository page by page.
-// See https://docs.github.com/rest/reference/repos#list-repository-tags
-func (s *RepoService) Tags(ctx context.Context, pageSize, pageNo int) ([]Tag, *Response, error) {
-	url := fmt.Sprintf("/repos/%s/%s/tags", s.owner, s.repo)
-	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+// Languages returns the languages of a given repository mapped to the number of bytes of code written in each.
+// See https://docs.github.com/rest/reference/repos#list-repository-languages
+func (s *RepoService) Languages(ctx context.Context) (map[string]int, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/languages", s.owner, s.repo)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	tags := []Tag{}
+	languages := map[string]int{}
 
-	resp, err := s.client.Do(req, &tags)
+	resp, err := s.client.Do(req, &languages)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	return tags, resp, nil
-}
-
-// IssuesParams are optional parameters for Issues.
-type IssuesParams struct {
-	State string
-	Since time.Time
+	return languages, resp, nil
 }
 
-// Issues retrieves all issues for a given repository page by page.
-// See https://docs.github.com/rest/reference/issues#list-repository-issues
-func (s *RepoService) Issues(ctx context.Context, pageSize, pageNo int, params IssuesParams) ([]Issue, *Response, error) {
-	url := fmt.Sprintf("/repos/%s/%s/issues", s.owner, s.repo)
-	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+// PrimaryLanguage returns the language with the most bytes of code in a given repository,
+// along with the percentage of code written in each language.
+// For a repository with no detected languages, it returns an empty string and an empty map.
+func (s *RepoService) PrimaryLanguage(ctx context.Context) (string, map[string]float64, *Response, error) {
+	languages, resp, err := s.Languages(ctx)
 	if err != nil {
-		return nil, nil, err
+		return "", nil, nil, err
 	}
 
-	q := req.URL.Query()
-
-	if params.State != "" {
-		q.Add("state", params.State)
+	var total int
+	for _, n := range languages {
+		total += n
 	}
 
-	if !params.Since.IsZero() {
-		q.Add("since", params.Since.Format(time.RFC3339))
+	var primary string
+	breakdown := map[string]float64{}
+
+	for lang, n := range languages {
+		if total > 0 {
+			breakdown[lang] = float64(n) * 100 / float64(total)
+		}
+
+		// Break ties alphabetically so the result is deterministic
+		if primary == "" || n > languages[primary] || (n == languages[primary] && lang < primary) {
+			primary = lang
+		}
 	}
 
-	req.URL.RawQuery = q.Encode()
+	return primary, breakdown, resp, nil
+}
 
-	issues := []Issue{}
+// Topics returns the topics of a given repository.
+// See https://docs.github.com/rest/reference/repos#get-all-repository-topics
+func (s *RepoService) Topics(ctx context.Context) ([]string, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/topics", s.owner, s.repo)
+	req, err := s.client.NewRequestWithMedia(ctx, "GET", url, nil, mediaTypeMercyPreview)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	resp, err := s.client.Do(req, &issues)
+	body := new(struct {
+		Names []string `json:"names"`
+	})
+
+	resp, err := s.client.Do(req, body)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	return issues, resp, nil
+	return body.Names, resp, nil
 }
 
-// Pull retrieves a pull request for a given repository by its number.
-// See https://docs.github.com/rest/reference/pulls#get-a-pull-request
-func (s *RepoService) Pull(ctx context.Context, number int) (*Pull, *Response, error) {
-	url := fmt.Sprintf("/repos/%s/%s/pulls/%d", s.owner, s.repo, number)
-	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+// ReplaceTopics replaces all topics of a given repository with the given topics.
+// See https://docs.github.com/rest/reference/repos#replace-all-repository-topics
+func (s *RepoService) ReplaceTopics(ctx context.Context, topics []string) ([]string, *Response, error) {
+	if topics == nil {
+		topics = []string{}
+	}
+
+	params := struct {
+		Names []string `json:"names"`
+	}{
+		Names: topics,
+	}
+
+	url := fmt.Sprintf("/repos/%s/%s/topics", s.owner, s.repo)
+	req, err := s.client.NewRequestWithMedia(ctx, "PUT", url, params, mediaTypeMercyPreview)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	pull := new(Pull)
+	body := new(struct {
+		Names []string `json:"names"`
+	})
 
-	resp, err := s.client.Do(req, pull)
+	resp, err := s.client.Do(req, body)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	return pull, resp, nil
+	return body.Names, resp, nil
 }
 
-// PullsParams are optional parameters for Pulls.
-type PullsParams struct {
-	State string
+// CodeOwners retrieves and parses the CODEOWNERS file of a given repository.
+// The file is looked up in the .github/ directory, the root directory, and the docs/ directory, in that order.
+// If ref is empty, the default branch of the repository is used.
+// If no CODEOWNERS file exists, a *NotFoundError is returned.
+// If the CODEOWNERS file has a pattern with an unsupported syntax, an error naming the line is returned.
+// See https://docs.github.com/en/github/creating-cloning-and-archiving-repositories/about-code-owners#codeowners-file-location
+func (s *RepoService) CodeOwners(ctx context.Context, ref string) (*CodeOwners, *Response, error) {
+	var notFoundErr *NotFoundError
+
+	for _, path := range []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"} {
+		file, _, resp, err := s.Contents(ctx, path, ref)
+		if errors.As(err, &notFoundErr) {
+			continue
+		} else if err != nil {
+			return nil, nil, err
+		}
+
+		// A directory named CODEOWNERS is not a CODEOWNERS file
+		if file == nil {
+			continue
+		}
+
+		content, err := file.DecodedContent()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		codeOwners, err := parseCodeOwners(path, content)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return codeOwners, resp, nil
+	}
+
+	if notFoundErr == nil {
+		notFoundErr = new(NotFoundError)
+	}
+
+	return nil, nil, notFoundErr
 }
 
-// Pulls retrieves all pull requests for a given repository page by page.
-// See https://docs.github.com/rest/reference/pulls#list-pull-requests
-func (s *RepoService) Pulls(ctx context.Context, pageSize, pageNo int, params PullsParams) ([]Pull, *Response, error) {
-	url := fmt.Sprintf("/repos/%s/%s/pulls", s.owner, s.repo)
+// Forks retrieves all forks of a given repository page by page.
+// See https://docs.github.com/rest/reference/repos#list-forks
+func (s *RepoService) Forks(ctx context.Context, pageSize, pageNo int) ([]Repository, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/forks", s.owner, s.repo)
 	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	q := req.URL.Query()
+	forks := []Repository{}
 
-	if params.State != "" {
+	resp, err := s.client.Do(req, &forks)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return forks, resp, nil
+}
+
+// CreateFork creates a fork of a given repository for the authenticated user or a given organization.
+// If organization is empty, the repository is forked into the authenticated user's account.
+// GitHub creates forks asynchronously, so the returned repository may not be accessible immediately.
+// See https://docs.github.com/rest/reference/repos#create-a-fork
+func (s *RepoService) CreateFork(ctx context.Context, organization string) (*Repository, *Response, error) {
+	body := struct {
+		Organization string `json:"organization,omitempty"`
+	}{
+		Organization: organization,
+	}
+
+	url := fmt.Sprintf("/repos/%s/%s/forks", s.owner, s.repo)
+	req, err := s.client.NewRequest(ctx, "POST", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fork := new(Repository)
+
+	resp, err := s.client.Do(req, fork)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return fork, resp, nil
+}
+
+// Star stars a given repository for the authenticated user.
+// See https://docs.github.com/rest/reference/activity#star-a-repository-for-the-authenticated-user
+func (s *RepoService) Star(ctx context.Context) (*Response, error) {
+	url := fmt.Sprintf("/user/starred/%s/%s", s.owner, s.repo)
+	req, err := s.client.NewRequest(ctx, "PUT", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Unstar unstars a given repository for the authenticated user.
+// See https://docs.github.com/rest/reference/activity#unstar-a-repository-for-the-authenticated-user
+func (s *RepoService) Unstar(ctx context.Context) (*Response, error) {
+	url := fmt.Sprintf("/user/starred/%s/%s", s.owner, s.repo)
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// IsStarred determines whether or not a given repository is starred by the authenticated user.
+// GitHub responds with 404 for a repository that is not starred, which is reported as false rather than a NotFoundError.
+// See https://docs.github.com/rest/reference/activity#check-if-a-repository-is-starred-by-the-authenticated-user
+func (s *RepoService) IsStarred(ctx context.Context) (bool, *Response, error) {
+	url := fmt.Sprintf("/user/starred/%s/%s", s.owner, s.repo)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		var notFoundErr *NotFoundError
+		if errors.As(err, &notFoundErr) && notFoundErr.err != nil {
+			return false, newResponse(notFoundErr.err.Response), nil
+		}
+		return false, nil, err
+	}
+
+	return true, resp, nil
+}
+
+// Contents retrieves the contents of a file or directory in a given repository.
+// If ref is empty, the default branch of the repository is used.
+// For a file, the first return value is set; for a directory, the second return value lists its entries.
+// See https://docs.github.com/rest/reference/repos#get-repository-content
+func (s *RepoService) Contents(ctx context.Context, path, ref string) (*RepoContent, []RepoContent, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/contents/%s", s.owner, s.repo, path)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if ref != "" {
+		q := req.URL.Query()
+		q.Add("ref", ref)
+		req.URL.RawQuery = q.Encode()
+	}
+
+	raw := json.RawMessage{}
+
+	resp, err := s.client.Do(req, &raw)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// A directory is returned as an array and a file as an object
+	if b := bytes.TrimSpace(raw); len(b) > 0 && b[0] == '[' {
+		contents := []RepoContent{}
+		if err := json.Unmarshal(b, &contents); err != nil {
+			return nil, nil, nil, err
+		}
+		return nil, contents, resp, nil
+	}
+
+	content := new(RepoContent)
+	if err := json.Unmarshal(raw, content); err != nil {
+		return nil, nil, nil, err
+	}
+
+	return content, nil, resp, nil
+}
+
+// DownloadContents streams the raw content of a file in a given repository to w.
+// If ref is empty, the default branch of the repository is used.
+// Unlike Contents, the file is not base64-encoded, so large files can be downloaded without buffering them in memory.
+// See https://docs.github.com/rest/reference/repos#get-repository-content
+func (s *RepoService) DownloadContents(ctx context.Context, path, ref string, w io.Writer) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/contents/%s", s.owner, s.repo, path)
+	req, err := s.client.NewRequestWithMedia(ctx, "GET", url, nil, mediaTypeV3Raw)
+	if err != nil {
+		return nil, err
+	}
+
+	if ref != "" {
+		q := req.URL.Query()
+		q.Add("ref", ref)
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := s.client.Do(req, w)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// ContributorsParams are optional parameters for Contributors.
+type ContributorsParams struct {
+	Anon bool
+}
+
+// Contributors retrieves all contributors for a given repository page by page.
+// See https://docs.github.com/rest/reference/repos#list-repository-contributors
+func (s *RepoService) Contributors(ctx context.Context, pageSize, pageNo int, params ContributorsParams) ([]Contributor, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/contributors", s.owner, s.repo)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := req.URL.Query()
+
+	if params.Anon {
+		q.Add("anon", "true")
+	}
+
+	req.URL.RawQuery = q.Encode()
+
+	contributors := []Contributor{}
+
+	resp, err := s.client.Do(req, &contributors)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return contributors, resp, nil
+}
+
+// PendingInvitations retrieves all pending invitations for a given repository page by page.
+// See https://docs.github.com/rest/reference/repos#list-repository-invitations
+func (s *RepoService) PendingInvitations(ctx context.Context, pageSize, pageNo int) ([]Invitation, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/invitations", s.owner, s.repo)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	invitations := []Invitation{}
+
+	resp, err := s.client.Do(req, &invitations)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return invitations, resp, nil
+}
+
+// DeleteInvitation deletes a pending invitation for a given repository.
+// See https://docs.github.com/rest/reference/repos#delete-a-repository-invitation
+func (s *RepoService) DeleteInvitation(ctx context.Context, invitationID int) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/invitations/%d", s.owner, s.repo, invitationID)
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Commit retrieves a commit for a given repository by its reference.
+// See https://docs.github.com/rest/reference/repos#get-a-commit
+func (s *RepoService) Commit(ctx context.Context, ref string) (*Commit, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/commits/%s", s.owner, s.repo, url.PathEscape(ref))
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	commit := new(Commit)
+
+	resp, err := s.client.Do(req, commit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return commit, resp, nil
+}
+
+// CommitDiff retrieves the changes of a commit in the unified diff format.
+// See https://docs.github.com/rest/reference/repos#get-a-commit
+func (s *RepoService) CommitDiff(ctx context.Context, ref string) (string, *Response, error) {
+	return s.commitText(ctx, ref, mediaTypeV3Diff)
+}
+
+// CommitSHA resolves a reference (branch, tag, or SHA) to the full SHA of its commit.
+// It is much cheaper than Commit since only the SHA is returned.
+// See https://docs.github.com/rest/reference/repos#get-a-commit
+func (s *RepoService) CommitSHA(ctx context.Context, ref string) (string, *Response, error) {
+	sha, resp, err := s.commitText(ctx, ref, mediaTypeV3SHA)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return strings.TrimSpace(sha), resp, nil
+}
+
+func (s *RepoService) commitText(ctx context.Context, ref, mediaType string) (string, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/commits/%s", s.owner, s.repo, url.PathEscape(ref))
+	req, err := s.client.NewRequestWithMedia(ctx, "GET", url, nil, mediaType)
+	if err != nil {
+		return "", nil, err
+	}
+
+	buf := new(bytes.Buffer)
+
+	resp, err := s.client.Do(req, buf)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return buf.String(), resp, nil
+}
+
+// CommitsByShas retrieves multiple commits by their SHAs concurrently using at most the given number of workers.
+// It returns all commits retrieved successfully.
+// If any of the commits cannot be retrieved, a *CommitsError with the error for each failed SHA is also returned.
+// If the context is cancelled, the remaining SHAs are not requested and fail with the context error.
+func (s *RepoService) CommitsByShas(ctx context.Context, shas []string, concurrency int) (map[string]*Commit, error) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	commits := map[string]*Commit{}
+	errs := map[string]error{}
+	shaCh := make(chan string)
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for sha := range shaCh {
+				commit, _, err := s.Commit(ctx, sha)
+
+				mu.Lock()
+				if err != nil {
+					errs[sha] = err
+				} else {
+					commits[sha] = commit
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for i, sha := range shas {
+		// Check the context first, since select picks randomly when a worker is also ready
+		if ctx.Err() == nil {
+			select {
+			case shaCh <- sha:
+				continue
+			case <-ctx.Done():
+			}
+		}
+
+		mu.Lock()
+		for _, sha := range shas[i:] {
+			errs[sha] = ctx.Err()
+		}
+		mu.Unlock()
+		break
+	}
+	close(shaCh)
+
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return commits, &CommitsError{
+			Errors: errs,
+		}
+	}
+
+	return commits, nil
+}
+
+// Commits retrieves all commits for a given repository page by page.
+// See https://docs.github.com/rest/reference/repos#list-commits
+func (s *RepoService) Commits(ctx context.Context, pageSize, pageNo int) ([]Commit, *Response, error) {
+	return s.CommitsWithParams(ctx, pageSize, pageNo, CommitsParams{})
+}
+
+// CommitsParams are optional parameters for CommitsWithParams.
+type CommitsParams struct {
+	// SHA is the SHA or branch to start listing commits from (default: the default branch).
+	SHA string
+	// Path restricts the results to commits touching a file path.
+	Path string
+	// Author is a GitHub login or email address.
+	Author string
+	Since  time.Time
+	Until  time.Time
+}
+
+// CommitsWithParams retrieves commits for a given repository filtered by the given parameters page by page.
+// See https://docs.github.com/rest/reference/repos#list-commits
+func (s *RepoService) CommitsWithParams(ctx context.Context, pageSize, pageNo int, params CommitsParams) ([]Commit, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/commits", s.owner, s.repo)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := req.URL.Query()
+
+	if params.SHA != "" {
+		q.Add("sha", params.SHA)
+	}
+
+	if params.Path != "" {
+		q.Add("path", params.Path)
+	}
+
+	if params.Author != "" {
+		q.Add("author", params.Author)
+	}
+
+	if !params.Since.IsZero() {
+		q.Add("since", params.Since.Format(time.RFC3339))
+	}
+
+	if !params.Until.IsZero() {
+		q.Add("until", params.Until.Format(time.RFC3339))
+	}
+
+	req.URL.RawQuery = q.Encode()
+
+	commits := []Commit{}
+
+	resp, err := s.client.Do(req, &commits)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return commits, resp, nil
+}
+
+// CommitsAll pages through all commits for a given repository and calls yield for each one.
+// It stops and returns the first error returned by either the API or yield.
+// The context is checked between pages and the response for the last page is returned.
+func (s *RepoService) CommitsAll(ctx context.Context, pageSize int, yield func(Commit) error) (*Response, error) {
+	var resp *Response
+
+	for pageNo := 1; pageNo > 0; {
+		if pageNo > 1 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+
+		commits, r, err := s.Commits(ctx, pageSize, pageNo)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, c := range commits {
+			if err := yield(c); err != nil {
+				return nil, err
+			}
+		}
+
+		resp = r
+		pageNo = r.Pages.Next
+	}
+
+	return resp, nil
+}
+
+// CommitsSince retrieves all commits on a given branch made after a given time.
+// It pages through all results and returns the response for the last page.
+// See https://docs.github.com/rest/reference/repos#list-commits
+func (s *RepoService) CommitsSince(ctx context.Context, branch string, since time.Time) ([]Commit, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/commits", s.owner, s.repo)
+
+	var resp *Response
+	commits := []Commit{}
+
+	for pageNo := 1; pageNo > 0; {
+		req, err := s.client.NewPageRequest(ctx, "GET", url, 100, pageNo, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		q := req.URL.Query()
+		q.Add("sha", branch)
+		q.Add("since", since.Format(time.RFC3339))
+		req.URL.RawQuery = q.Encode()
+
+		page := []Commit{}
+
+		resp, err = s.client.Do(req, &page)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		commits = append(commits, page...)
+		pageNo = resp.Pages.Next
+	}
+
+	return commits, resp, nil
+}
+
+// CommitsByEmail retrieves commits for a given repository page by page and returns only those authored with the given email.
+// GitHub only filters commits by the author login, so the email filtering is done client-side over each page of results.
+// As a result, a page may contain fewer commits than the page size, and pagination should still follow the returned Response.
+func (s *RepoService) CommitsByEmail(ctx context.Context, email string, pageSize, pageNo int) ([]Commit, *Response, error) {
+	commits, resp, err := s.Commits(ctx, pageSize, pageNo)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	filtered := []Commit{}
+	for _, c := range commits {
+		if strings.EqualFold(c.Commit.Author.Email, email) {
+			filtered = append(filtered, c)
+		}
+	}
+
+	return filtered, resp, nil
+}
+
+// CompareCommits compares two commits, branches, or tags.
+// See https://docs.github.com/rest/reference/repos#compare-two-commits
+func (s *RepoService) CompareCommits(ctx context.Context, base, head string) (*Comparison, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/compare/%s...%s", s.owner, s.repo, url.PathEscape(base), url.PathEscape(head))
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comparison := new(Comparison)
+
+	resp, err := s.client.Do(req, comparison)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return comparison, resp, nil
+}
+
+// DiffStat returns the number of changed files, additions, and deletions between two commits.
+// It is the equivalent of the summary line of git diff --stat.
+// See https://docs.github.com/rest/reference/repos#compare-two-commits
+func (s *RepoService) DiffStat(ctx context.Context, base, head string) (files, additions, deletions int, resp *Response, err error) {
+	comparison, resp, err := s.CompareCommits(ctx, base, head)
+	if err != nil {
+		return 0, 0, 0, nil, err
+	}
+
+	for _, f := range comparison.Files {
+		additions += f.Additions
+		deletions += f.Deletions
+	}
+
+	return len(comparison.Files), additions, deletions, resp, nil
+}
+
+// Statuses retrieves all commit statuses for a given ref page by page.
+// See https://docs.github.com/rest/reference/repos#list-commit-statuses-for-a-reference
+func (s *RepoService) Statuses(ctx context.Context, ref string, pageSize, pageNo int) ([]Status, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/commits/%s/statuses", s.owner, s.repo, url.PathEscape(ref))
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	statuses := []Status{}
+
+	resp, err := s.client.Do(req, &statuses)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return statuses, resp, nil
+}
+
+// CreateStatus creates a new commit status for a given ref.
+// Only the state, target URL, description, and context of the given status are sent.
+// See https://docs.github.com/rest/reference/repos#create-a-commit-status
+func (s *RepoService) CreateStatus(ctx context.Context, ref string, status Status) (*Status, *Response, error) {
+	if err := status.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	body := struct {
+		State       string `json:"state"`
+		TargetURL   string `json:"target_url,omitempty"`
+		Description string `json:"description,omitempty"`
+		Context     string `json:"context,omitempty"`
+	}{
+		State:       status.State,
+		TargetURL:   status.TargetURL,
+		Description: status.Description,
+		Context:     status.Context,
+	}
+
+	url := fmt.Sprintf("/repos/%s/%s/statuses/%s", s.owner, s.repo, url.PathEscape(ref))
+	req, err := s.client.NewRequest(ctx, "POST", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(Status)
+
+	resp, err := s.client.Do(req, created)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return created, resp, nil
+}
+
+// CombinedStatus retrieves the combined status for a given ref.
+// See https://docs.github.com/rest/reference/repos#get-the-combined-status-for-a-specific-reference
+func (s *RepoService) CombinedStatus(ctx context.Context, ref string) (*CombinedStatus, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/commits/%s/status", s.owner, s.repo, url.PathEscape(ref))
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	combined := new(CombinedStatus)
+
+	resp, err := s.client.Do(req, combined)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return combined, resp, nil
+}
+
+// CheckRuns retrieves all check runs for a given ref page by page.
+// See https://docs.github.com/rest/reference/checks#list-check-runs-for-a-git-reference
+func (s *RepoService) CheckRuns(ctx context.Context, ref string, pageSize, pageNo int) ([]CheckRun, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/commits/%s/check-runs", s.owner, s.repo, url.PathEscape(ref))
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body := new(struct {
+		TotalCount int        `json:"total_count"`
+		CheckRuns  []CheckRun `json:"check_runs"`
+	})
+
+	resp, err := s.client.Do(req, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return body.CheckRuns, resp, nil
+}
+
+// Branch retrieves a branch for a given repository by its name.
+// See https://docs.github.com/rest/reference/repos#get-a-branch
+func (s *RepoService) Branch(ctx context.Context, name string) (*Branch, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/branches/%s", s.owner, s.repo, url.PathEscape(name))
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	branch := new(Branch)
+
+	resp, err := s.client.Do(req, branch)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return branch, resp, nil
+}
+
+// BranchProtection enables/disables a branch protection for administrator users.
+// See https://docs.github.com/rest/reference/repos#set-admin-branch-protection
+// See https://docs.github.com/rest/reference/repos#delete-admin-branch-protection
+func (s *RepoService) BranchProtection(ctx context.Context, branch string, enabled bool) (*Response, error) {
+	var method string
+	if enabled {
+		method = "POST"
+	} else {
+		method = "DELETE"
+	}
+
+	url := fmt.Sprintf("/repos/%s/%s/branches/%s/protection/enforce_admins", s.owner, s.repo, url.PathEscape(branch))
+	req, err := s.client.NewRequest(ctx, method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetBranchProtection retrieves the protection rules of a given branch.
+// If the branch is not protected, a NotFoundError is returned.
+// See https://docs.github.com/rest/reference/repos#get-branch-protection
+func (s *RepoService) GetBranchProtection(ctx context.Context, branch string) (*BranchProtection, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/branches/%s/protection", s.owner, s.repo, url.PathEscape(branch))
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	protection := new(BranchProtection)
+
+	resp, err := s.client.Do(req, protection)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return protection, resp, nil
+}
+
+// SetBranchProtection replaces the protection rules of a given branch.
+// See https://docs.github.com/rest/reference/repos#update-branch-protection
+func (s *RepoService) SetBranchProtection(ctx context.Context, branch string, rules BranchProtectionRules) (*BranchProtection, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/branches/%s/protection", s.owner, s.repo, url.PathEscape(branch))
+	req, err := s.client.NewRequest(ctx, "PUT", url, rules)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	protection := new(BranchProtection)
+
+	resp, err := s.client.Do(req, protection)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return protection, resp, nil
+}
+
+// DeleteBranchProtection removes all protection rules of a given branch.
+// See https://docs.github.com/rest/reference/repos#delete-branch-protection
+func (s *RepoService) DeleteBranchProtection(ctx context.Context, branch string) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/branches/%s/protection", s.owner, s.repo, url.PathEscape(branch))
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// ActivityParams are optional parameters for Activity.
+type ActivityParams struct {
+	Ref          string
+	ActivityType string
+	TimePeriod   string
+	Actor        string
+}
+
+// Activity retrieves the activity for a given repository page by page.
+// This includes pushes, merges, force pushes, and branch creations and deletions.
+// See https://docs.github.com/rest/reference/repos#list-repository-activities
+func (s *RepoService) Activity(ctx context.Context, pageSize, pageNo int, params ActivityParams) ([]RepoActivity, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/activity", s.owner, s.repo)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := req.URL.Query()
+
+	if params.Ref != "" {
+		q.Add("ref", params.Ref)
+	}
+
+	if params.ActivityType != "" {
+		q.Add("activity_type", params.ActivityType)
+	}
+
+	if params.TimePeriod != "" {
+		q.Add("time_period", params.TimePeriod)
+	}
+
+	if params.Actor != "" {
+		q.Add("actor", params.Actor)
+	}
+
+	req.URL.RawQuery = q.Encode()
+
+	activities := []RepoActivity{}
+
+	resp, err := s.client.Do(req, &activities)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return activities, resp, nil
+}
+
+// WorkflowRun retrieves a workflow run for a given repository by its id.
+// See https://docs.github.com/rest/reference/actions#get-a-workflow-run
+func (s *RepoService) WorkflowRun(ctx context.Context, runID int) (*WorkflowRun, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/actions/runs/%d", s.owner, s.repo, runID)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	run := new(WorkflowRun)
+
+	resp, err := s.client.Do(req, run)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return run, resp, nil
+}
+
+// WaitForWorkflowRun polls a workflow run every interval until its status is completed.
+// It returns the completed run, whose Conclusion holds the outcome, and the response for the last poll.
+// The wait stops when the context is cancelled or its deadline is exceeded.
+// interval must be positive, so the run is not polled as fast as possible at the expense of the rate limit.
+func (s *RepoService) WaitForWorkflowRun(ctx context.Context, runID int, interval time.Duration) (*WorkflowRun, *Response, error) {
+	if interval <= 0 {
+		return nil, nil, fmt.Errorf("invalid interval %s: interval must be positive", interval)
+	}
+
+	for {
+		run, resp, err := s.WorkflowRun(ctx, runID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if run.Status == "completed" {
+			return run, resp, nil
+		}
+
+		if err := sleep(ctx, interval); err != nil {
+			return nil, nil, err
+		}
+	}
+}
+
+// Webhooks retrieves all webhooks for a given repository page by page.
+// See https://docs.github.com/rest/reference/repos#list-repository-webhooks
+func (s *RepoService) Webhooks(ctx context.Context, pageSize, pageNo int) ([]Webhook, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/hooks", s.owner, s.repo)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hooks := []Webhook{}
+
+	resp, err := s.client.Do(req, &hooks)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return hooks, resp, nil
+}
+
+// CreateWebhook creates a new webhook for a given repository.
+// Only the name, active flag, events, and config of the given webhook are sent.
+// See https://docs.github.com/rest/reference/repos#create-a-repository-webhook
+func (s *RepoService) CreateWebhook(ctx context.Context, hook Webhook) (*Webhook, *Response, error) {
+	body := struct {
+		Name   string            `json:"name,omitempty"`
+		Active bool              `json:"active"`
+		Events []string          `json:"events,omitempty"`
+		Config map[string]string `json:"config"`
+	}{
+		Name:   hook.Name,
+		Active: hook.Active,
+		Events: hook.Events,
+		Config: hook.Config,
+	}
+
+	url := fmt.Sprintf("/repos/%s/%s/hooks", s.owner, s.repo)
+	req, err := s.client.NewRequest(ctx, "POST", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(Webhook)
+
+	resp, err := s.client.Do(req, created)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return created, resp, nil
+}
+
+// DeleteWebhook deletes a webhook for a given repository by its id.
+// See https://docs.github.com/rest/reference/repos#delete-a-repository-webhook
+func (s *RepoService) DeleteWebhook(ctx context.Context, hookID int) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/hooks/%d", s.owner, s.repo, hookID)
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// HookDeliveries retrieves all deliveries for a given repository webhook page by page.
+// See https://docs.github.com/rest/reference/repos#list-deliveries-for-a-repository-webhook
+func (s *RepoService) HookDeliveries(ctx context.Context, hookID, pageSize, pageNo int) ([]HookDelivery, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/hooks/%d/deliveries", s.owner, s.repo, hookID)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deliveries := []HookDelivery{}
+
+	resp, err := s.client.Do(req, &deliveries)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return deliveries, resp, nil
+}
+
+// RedeliverHook requests a redelivery of a webhook delivery for a given repository webhook.
+// GitHub accepts the request and performs the redelivery asynchronously.
+// See https://docs.github.com/rest/reference/repos#redeliver-a-delivery-for-a-repository-webhook
+func (s *RepoService) RedeliverHook(ctx context.Context, hookID, deliveryID int) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/hooks/%d/deliveries/%d/attempts", s.owner, s.repo, hookID, deliveryID)
+	req, err := s.client.NewRequest(ctx, "POST", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Tags retrieves all tags for a given repository page by page.
+// See https://docs.github.com/rest/reference/repos#list-repository-tags
+func (s *RepoService) Tags(ctx context.Context, pageSize, pageNo int) ([]Tag, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/tags", s.owner, s.repo)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tags := []Tag{}
+
+	resp, err := s.client.Do(req, &tags)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tags, resp, nil
+}
+
+// GitTag retrieves an annotated tag object by its SHA.
+// The SHA is the one of the tag object and not the commit it points to.
+// See https://docs.github.com/rest/reference/git#get-a-tag
+func (s *RepoService) GitTag(ctx context.Context, sha string) (*GitTag, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/git/tags/%s", s.owner, s.repo, sha)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tag := new(GitTag)
+
+	resp, err := s.client.Do(req, tag)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tag, resp, nil
+}
+
+// TagRef retrieves the Git reference of a tag by its name.
+// For a lightweight tag, the reference points to a commit; for an annotated tag, it points to a tag object (see GitTag).
+// If the tag does not exist, a *NotFoundError is returned.
+// See https://docs.github.com/rest/reference/git#get-a-reference
+func (s *RepoService) TagRef(ctx context.Context, tag string) (*Reference, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/git/ref/tags/%s", s.owner, s.repo, url.PathEscape(tag))
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ref := new(Reference)
+
+	resp, err := s.client.Do(req, ref)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ref, resp, nil
+}
+
+// CreateTagObject creates an annotated tag object pointing to a commit.
+// The tag object does not create the tag reference, so the tag is not listed until a reference is created for it.
+// See https://docs.github.com/rest/reference/git#create-a-tag-object
+func (s *RepoService) CreateTagObject(ctx context.Context, tag, message, sha, taggerName, taggerEmail string) (*GitTag, *Response, error) {
+	body := struct {
+		Tag     string    `json:"tag"`
+		Message string    `json:"message"`
+		Object  string    `json:"object"`
+		Type    string    `json:"type"`
+		Tagger  Signature `json:"tagger"`
+	}{
+		Tag:     tag,
+		Message: message,
+		Object:  sha,
+		Type:    "commit",
+		Tagger: Signature{
+			Name:  taggerName,
+			Email: taggerEmail,
+			Time:  time.Now().UTC(),
+		},
+	}
+
+	url := fmt.Sprintf("/repos/%s/%s/git/tags", s.owner, s.repo)
+	req, err := s.client.NewRequest(ctx, "POST", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(GitTag)
+
+	resp, err := s.client.Do(req, created)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return created, resp, nil
+}
+
+// MilestonesParams are optional parameters for Milestones.
+type MilestonesParams struct {
+	State string
+}
+
+// Milestones retrieves all milestones for a given repository page by page.
+// See https://docs.github.com/rest/reference/issues#list-milestones
+func (s *RepoService) Milestones(ctx context.Context, pageSize, pageNo int, params MilestonesParams) ([]Milestone, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/milestones", s.owner, s.repo)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if params.State != "" {
+		q := req.URL.Query()
+		q.Add("state", params.State)
+		req.URL.RawQuery = q.Encode()
+	}
+
+	milestones := []Milestone{}
+
+	resp, err := s.client.Do(req, &milestones)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return milestones, resp, nil
+}
+
+// CreateMilestone creates a new milestone for a given repository.
+// The state and due date are optional and can be left empty.
+// See https://docs.github.com/rest/reference/issues#create-a-milestone
+func (s *RepoService) CreateMilestone(ctx context.Context, title, description, state string, dueOn *time.Time) (*Milestone, *Response, error) {
+	body := struct {
+		Title       string     `json:"title"`
+		Description string     `json:"description,omitempty"`
+		State       string     `json:"state,omitempty"`
+		DueOn       *time.Time `json:"due_on,omitempty"`
+	}{
+		Title:       title,
+		Description: description,
+		State:       state,
+		DueOn:       dueOn,
+	}
+
+	url := fmt.Sprintf("/repos/%s/%s/milestones", s.owner, s.repo)
+	req, err := s.client.NewRequest(ctx, "POST", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	milestone := new(Milestone)
+
+	resp, err := s.client.Do(req, milestone)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return milestone, resp, nil
+}
+
+// IssuesParams are optional parameters for Issues.
+type IssuesParams struct {
+	State string
+	Since time.Time
+
+	// Labels restricts the results to issues having all of the given labels.
+	Labels []string
+	// Assignee is a GitHub login, none for issues with no assignee, or * for issues with any assignee.
+	Assignee  string
+	Creator   string
+	Mentioned string
+	// Sort is one of created, updated, or comments.
+	Sort string
+	// Direction is either asc or desc.
+	Direction string
+
+	// ModifiedSince makes the request conditional using the If-Modified-Since header.
+	// If the issues have not changed since then, ErrNotModified is returned.
+	// The request bypasses the cache set by WithCache, so ErrNotModified is returned even if the issues are cached.
+	ModifiedSince time.Time
+}
+
+// Issues retrieves all issues for a given repository page by page.
+// See https://docs.github.com/rest/reference/issues#list-repository-issues
+func (s *RepoService) Issues(ctx context.Context, pageSize, pageNo int, params IssuesParams) ([]Issue, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/issues", s.owner, s.repo)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := req.URL.Query()
+
+	if params.State != "" {
 		q.Add("state", params.State)
 	}
 
+	if !params.Since.IsZero() {
+		q.Add("since", params.Since.Format(time.RFC3339))
+	}
+
+	if len(params.Labels) > 0 {
+		q.Add("labels", strings.Join(params.Labels, ","))
+	}
+
+	if params.Assignee != "" {
+		q.Add("assignee", params.Assignee)
+	}
+
+	if params.Creator != "" {
+		q.Add("creator", params.Creator)
+	}
+
+	if params.Mentioned != "" {
+		q.Add("mentioned", params.Mentioned)
+	}
+
+	if params.Sort != "" {
+		q.Add("sort", params.Sort)
+	}
+
+	if params.Direction != "" {
+		q.Add("direction", params.Direction)
+	}
+
+	req.URL.RawQuery = q.Encode()
+
+	if !params.ModifiedSince.IsZero() {
+		req.Header.Set(headerIfModified, params.ModifiedSince.UTC().Format(http.TimeFormat))
+	}
+
+	issues := []Issue{}
+
+	resp, err := s.client.Do(req, &issues)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return issues, resp, nil
+}
+
+// ExportIssues pages through all issues for a given repository and writes them to w as newline-delimited JSON.
+// Each page is encoded and written to w at once, so only one page of issues is kept in memory.
+// If w has a Flush method (e.g. *bufio.Writer), it is flushed after each page.
+// The context is checked between pages and the response for the last page is returned.
+func (s *RepoService) ExportIssues(ctx context.Context, params IssuesParams, w io.Writer) (*Response, error) {
+	var resp *Response
+
+	for pageNo := 1; pageNo > 0; {
+		if pageNo > 1 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+
+		issues, r, err := s.Issues(ctx, 100, pageNo, params)
+		if err != nil {
+			return nil, err
+		}
+
+		buf := new(bytes.Buffer)
+		enc := json.NewEncoder(buf)
+		for _, issue := range issues {
+			if err := enc.Encode(issue); err != nil {
+				return nil, err
+			}
+		}
+
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			return nil, err
+		}
+
+		if f, ok := w.(interface{ Flush() error }); ok {
+			if err := f.Flush(); err != nil {
+				return nil, err
+			}
+		}
+
+		resp = r
+		pageNo = r.Pages.Next
+	}
+
+	return resp, nil
+}
+
+// Issue retrieves an issue for a given repository by its number.
+// GitHub considers every pull request an issue, so a pull request number returns an issue with PullURLs set.
+// If the issue does not exist, a *NotFoundError is returned.
+// See https://docs.github.com/rest/reference/issues#get-an-issue
+func (s *RepoService) Issue(ctx context.Context, number int) (*Issue, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/issues/%d", s.owner, s.repo, number)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issue := new(Issue)
+
+	resp, err := s.client.Do(req, issue)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return issue, resp, nil
+}
+
+// UpdateIssue updates an issue for a given repository by its number.
+// To close an issue as a duplicate or won't-fix, set State to closed and StateReason to not_planned.
+// See https://docs.github.com/rest/reference/issues#update-an-issue
+func (s *RepoService) UpdateIssue(ctx context.Context, number int, params IssueParams) (*Issue, *Response, error) {
+	if err := params.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	url := fmt.Sprintf("/repos/%s/%s/issues/%d", s.owner, s.repo, number)
+	req, err := s.client.NewRequest(ctx, "PATCH", url, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issue := new(Issue)
+
+	resp, err := s.client.Do(req, issue)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return issue, resp, nil
+}
+
+// Pull retrieves a pull request for a given repository by its number.
+// See https://docs.github.com/rest/reference/pulls#get-a-pull-request
+func (s *RepoService) Pull(ctx context.Context, number int) (*Pull, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/pulls/%d", s.owner, s.repo, number)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pull := new(Pull)
+
+	resp, err := s.client.Do(req, pull)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pull, resp, nil
+}
+
+// PullDiff retrieves the diff of a pull request in the unified diff format.
+// See https://docs.github.com/rest/reference/pulls#get-a-pull-request
+func (s *RepoService) PullDiff(ctx context.Context, number int) (string, *Response, error) {
+	return s.pullText(ctx, number, mediaTypeV3Diff)
+}
+
+// PullPatch retrieves the patch of a pull request in the git format-patch format.
+// See https://docs.github.com/rest/reference/pulls#get-a-pull-request
+func (s *RepoService) PullPatch(ctx context.Context, number int) (string, *Response, error) {
+	return s.pullText(ctx, number, mediaTypeV3Patch)
+}
+
+func (s *RepoService) pullText(ctx context.Context, number int, mediaType string) (string, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/pulls/%d", s.owner, s.repo, number)
+	req, err := s.client.NewRequestWithMedia(ctx, "GET", url, nil, mediaType)
+	if err != nil {
+		return "", nil, err
+	}
+
+	buf := new(bytes.Buffer)
+
+	resp, err := s.client.Do(req, buf)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return buf.String(), resp, nil
+}
+
+// PullsParams are optional parameters for Pulls.
+type PullsParams struct {
+	State string
+	// Head restricts the results to pull requests from a branch in the user:ref-name format.
+	Head string
+	// Base restricts the results to pull requests targeting a branch.
+	Base string
+	// Sort is one of created, updated, popularity, or long-running.
+	Sort string
+	// Direction is either asc or desc.
+	Direction string
+
+	// MergedSince and MergedUntil restrict the results to pull requests merged within a date range.
+	// GitHub does not support filtering by merge date, so this is done client-side.
+	// If either is set, only closed pull requests sorted by their last update are requested,
+	// and State, Sort, and Direction are ignored.
+	MergedSince time.Time
+	MergedUntil time.Time
+}
+
+func (p PullsParams) filterMerged() bool {
+	return !p.MergedSince.IsZero() || !p.MergedUntil.IsZero()
+}
+
+// Pulls retrieves all pull requests for a given repository page by page.
+// See https://docs.github.com/rest/reference/pulls#list-pull-requests
+func (s *RepoService) Pulls(ctx context.Context, pageSize, pageNo int, params PullsParams) ([]Pull, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/pulls", s.owner, s.repo)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := req.URL.Query()
+
+	if params.filterMerged() {
+		q.Add("state", "closed")
+		q.Add("sort", "updated")
+		q.Add("direction", "desc")
+	} else {
+		if params.State != "" {
+			q.Add("state", params.State)
+		}
+
+		if params.Sort != "" {
+			q.Add("sort", params.Sort)
+		}
+
+		if params.Direction != "" {
+			q.Add("direction", params.Direction)
+		}
+	}
+
+	if params.Head != "" {
+		q.Add("head", params.Head)
+	}
+
+	if params.Base != "" {
+		q.Add("base", params.Base)
+	}
+
 	req.URL.RawQuery = q.Encode()
 
 	pulls := []Pull{}
@@ -491,9 +2385,175 @@ func (s *RepoService) Pulls(ctx context.Context, pageSize, pageNo int, params Pu
 		return nil, nil, err
 	}
 
+	if params.filterMerged() {
+		filtered := []Pull{}
+		for _, p := range pulls {
+			if p.MergedAt == nil {
+				continue
+			}
+			if !params.MergedSince.IsZero() && p.MergedAt.Before(params.MergedSince) {
+				continue
+			}
+			if !params.MergedUntil.IsZero() && p.MergedAt.After(params.MergedUntil) {
+				continue
+			}
+			filtered = append(filtered, p)
+		}
+
+		// A pull request cannot be merged after its last update.
+		// Once the results are older than MergedSince, no later page can contain a match.
+		if !params.MergedSince.IsZero() && len(pulls) > 0 && pulls[len(pulls)-1].UpdatedAt.Before(params.MergedSince) {
+			resp.Pages.Next = 0
+			resp.Pages.Last = 0
+		}
+
+		pulls = filtered
+	}
+
 	return pulls, resp, nil
 }
 
+// OpenPullsByBase counts the open pull requests for a given repository by their base branch.
+// It pages through all open pull requests and returns the response for the last page.
+func (s *RepoService) OpenPullsByBase(ctx context.Context) (map[string]int, *Response, error) {
+	var resp *Response
+	counts := map[string]int{}
+
+	for pageNo := 1; pageNo > 0; {
+		if pageNo > 1 {
+			if err := ctx.Err(); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		pulls, r, err := s.Pulls(ctx, 100, pageNo, PullsParams{State: "open"})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, p := range pulls {
+			counts[p.Base.Ref]++
+		}
+
+		resp = r
+		pageNo = r.Pages.Next
+	}
+
+	return counts, resp, nil
+}
+
+// RequestReviewers requests reviews from users and teams for a pull request.
+// See https://docs.github.com/rest/reference/pulls#request-reviewers-for-a-pull-request
+func (s *RepoService) RequestReviewers(ctx context.Context, number int, reviewers, teamReviewers []string) (*Pull, *Response, error) {
+	body := struct {
+		Reviewers     []string `json:"reviewers,omitempty"`
+		TeamReviewers []string `json:"team_reviewers,omitempty"`
+	}{
+		Reviewers:     reviewers,
+		TeamReviewers: teamReviewers,
+	}
+
+	url := fmt.Sprintf("/repos/%s/%s/pulls/%d/requested_reviewers", s.owner, s.repo, number)
+	req, err := s.client.NewRequest(ctx, "POST", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pull := new(Pull)
+
+	resp, err := s.client.Do(req, pull)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pull, resp, nil
+}
+
+// PullCommits retrieves all commits in a pull request page by page.
+// See https://docs.github.com/rest/reference/pulls#list-commits-on-a-pull-request
+func (s *RepoService) PullCommits(ctx context.Context, number, pageSize, pageNo int) ([]Commit, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/pulls/%d/commits", s.owner, s.repo, number)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	commits := []Commit{}
+
+	resp, err := s.client.Do(req, &commits)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return commits, resp, nil
+}
+
+// PullFiles retrieves all files changed in a pull request page by page.
+// See https://docs.github.com/rest/reference/pulls#list-pull-requests-files
+func (s *RepoService) PullFiles(ctx context.Context, number, pageSize, pageNo int) ([]PullFile, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/pulls/%d/files", s.owner, s.repo, number)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	files := []PullFile{}
+
+	resp, err := s.client.Do(req, &files)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return files, resp, nil
+}
+
+// PullReviews retrieves all reviews for a pull request page by page.
+// See https://docs.github.com/rest/reference/pulls#list-reviews-for-a-pull-request
+func (s *RepoService) PullReviews(ctx context.Context, number, pageSize, pageNo int) ([]Review, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/pulls/%d/reviews", s.owner, s.repo, number)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reviews := []Review{}
+
+	resp, err := s.client.Do(req, &reviews)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return reviews, resp, nil
+}
+
+// CreatePullReview creates a review for a pull request.
+// The event can be one of APPROVE, REQUEST_CHANGES, or COMMENT.
+// See https://docs.github.com/rest/reference/pulls#create-a-review-for-a-pull-request
+func (s *RepoService) CreatePullReview(ctx context.Context, number int, event, body string) (*Review, *Response, error) {
+	params := struct {
+		Event string `json:"event"`
+		Body  string `json:"body,omitempty"`
+	}{
+		Event: event,
+		Body:  body,
+	}
+
+	url := fmt.Sprintf("/repos/%s/%s/pulls/%d/reviews", s.owner, s.repo, number)
+	req, err := s.client.NewRequest(ctx, "POST", url, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	review := new(Review)
+
+	resp, err := s.client.Do(req, review)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return review, resp, nil
+}
+
 // Events retrieves all events for a given repository and an issue page by page.
 // See https://docs.github.com/rest/reference/issues#list-issue-events
 func (s *RepoService) Events(ctx context.Context, number, pageSize, pageNo int) ([]Event, *Response, error) {
@@ -513,6 +2573,64 @@ func (s *RepoService) Events(ctx context.Context, number, pageSize, pageNo int)
 	return events, resp, nil
 }
 
+// Timeline retrieves all timeline events for a given repository and an issue page by page.
+// Unlike Events, the timeline includes comments, cross-references, and other events of the issue.
+// See https://docs.github.com/rest/reference/issues#list-timeline-events-for-an-issue
+func (s *RepoService) Timeline(ctx context.Context, number, pageSize, pageNo int) ([]TimelineEvent, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/issues/%d/timeline", s.owner, s.repo, number)
+	req, err := s.client.NewPageRequestWithMedia(ctx, "GET", url, pageSize, pageNo, nil, mediaTypeMockingbirdPreview)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	events := []TimelineEvent{}
+
+	resp, err := s.client.Do(req, &events)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return events, resp, nil
+}
+
+// Releases retrieves all releases for a given repository page by page.
+// See https://docs.github.com/rest/reference/repos#list-releases
+func (s *RepoService) Releases(ctx context.Context, pageSize, pageNo int) ([]Release, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/releases", s.owner, s.repo)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	releases := []Release{}
+
+	resp, err := s.client.Do(req, &releases)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return releases, resp, nil
+}
+
+// ReleasesWithAssets retrieves the releases that have at least one asset for a given repository page by page.
+// Releases without any asset are filtered out client-side, so a page may have fewer than pageSize releases.
+// See https://docs.github.com/rest/reference/repos#list-releases
+func (s *RepoService) ReleasesWithAssets(ctx context.Context, pageSize, pageNo int) ([]Release, *Response, error) {
+	releases, resp, err := s.Releases(ctx, pageSize, pageNo)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	filtered := []Release{}
+	for _, r := range releases {
+		if len(r.Assets) > 0 {
+			filtered = append(filtered, r)
+		}
+	}
+
+	return filtered, resp, nil
+}
+
 // LatestRelease returns the latest GitHub release.
 // The latest release is the most recent non-prerelease and non-draft release.
 // See https://docs.github.com/rest/reference/repos#get-the-latest-release
@@ -536,6 +2654,10 @@ func (s *RepoService) LatestRelease(ctx context.Context) (*Release, *Response, e
 // CreateRelease creates a new GitHub release.
 // See https://docs.github.com/rest/reference/repos#create-a-release
 func (s *RepoService) CreateRelease(ctx context.Context, params ReleaseParams) (*Release, *Response, error) {
+	if err := params.Validate(); err != nil {
+		return nil, nil, err
+	}
+
 	url := fmt.Sprintf("/repos/%s/%s/releases", s.owner, s.repo)
 	req, err := s.client.NewRequest(ctx, "POST", url, params)
 	if err != nil {
@@ -555,6 +2677,10 @@ func (s *RepoService) CreateRelease(ctx context.Context, params ReleaseParams) (
 // UpdateRelease updates an existing GitHub release.
 // See https://docs.github.com/rest/reference/repos#update-a-release
 func (s *RepoService) UpdateRelease(ctx context.Context, releaseID int, params ReleaseParams) (*Release, *Response, error) {
+	if err := params.Validate(); err != nil {
+		return nil, nil, err
+	}
+
 	url := fmt.Sprintf("/repos/%s/%s/releases/%d", s.owner, s.repo, releaseID)
 	req, err := s.client.NewRequest(ctx, "PATCH", url, params)
 	if err != nil {
@@ -602,7 +2728,7 @@ func (s *RepoService) UploadReleaseAsset(ctx context.Context, releaseID int, ass
 
 // DownloadReleaseAsset downloads an asset from a GitHub release.
 func (s *RepoService) DownloadReleaseAsset(ctx context.Context, releaseTag, assetName string, w io.Writer) (*Response, error) {
-	url := fmt.Sprintf("/%s/%s/releases/download/%s/%s", s.owner, s.repo, releaseTag, assetName)
+	url := fmt.Sprintf("/%s/%s/releases/download/%s/%s", s.owner, s.repo, url.PathEscape(releaseTag), url.PathEscape(assetName))
 	req, err := s.client.NewDownloadRequest(ctx, url)
 	if err != nil {
 		return nil, err
@@ -616,9 +2742,155 @@ func (s *RepoService) DownloadReleaseAsset(ctx context.Context, releaseTag, asse
 	return resp, nil
 }
 
+// DownloadReleaseAssetByID downloads a release asset by its id through the API.
+// GitHub responds with a redirect to a signed storage URL, which is followed automatically.
+// The HTTP client does not forward the Authorization header to a different host, so the access token is not sent to the storage.
+// See https://docs.github.com/rest/reference/repos#get-a-release-asset
+func (s *RepoService) DownloadReleaseAssetByID(ctx context.Context, assetID int, w io.Writer) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/releases/assets/%d", s.owner, s.repo, assetID)
+	req, err := s.client.NewRequestWithMedia(ctx, "GET", url, nil, mediaOctetStream)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, w)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Deployments retrieves all deployments for a given repository page by page.
+// See https://docs.github.com/rest/reference/repos#list-deployments
+func (s *RepoService) Deployments(ctx context.Context, pageSize, pageNo int) ([]Deployment, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/deployments", s.owner, s.repo)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deployments := []Deployment{}
+
+	resp, err := s.client.Do(req, &deployments)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return deployments, resp, nil
+}
+
+// CreateDeployment creates a new deployment for a given ref.
+// See https://docs.github.com/rest/reference/repos#create-a-deployment
+func (s *RepoService) CreateDeployment(ctx context.Context, params DeploymentParams) (*Deployment, *Response, error) {
+	if err := params.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	url := fmt.Sprintf("/repos/%s/%s/deployments", s.owner, s.repo)
+	req, err := s.client.NewRequest(ctx, "POST", url, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deployment := new(Deployment)
+
+	resp, err := s.client.Do(req, deployment)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return deployment, resp, nil
+}
+
+// CreateDeploymentStatus creates a new status for a given deployment.
+// Only the state, description, environment, target URL, and log URL of the given status are sent.
+// The preview media types for the in_progress and queued states and the environment and log URL fields are requested.
+// See https://docs.github.com/rest/reference/repos#create-a-deployment-status
+func (s *RepoService) CreateDeploymentStatus(ctx context.Context, deploymentID int, status DeploymentStatus) (*DeploymentStatus, *Response, error) {
+	return s.CreateDeploymentStatusWithMedia(ctx, deploymentID, status, mediaTypeFlashPreview+", "+mediaTypeAntManPreview)
+}
+
+// CreateDeploymentStatusWithMedia creates a new status for a given deployment with a custom media type.
+// The Accept header is set to the given media type instead of the preview media types requested by CreateDeploymentStatus.
+// See https://docs.github.com/rest/reference/repos#create-a-deployment-status
+func (s *RepoService) CreateDeploymentStatusWithMedia(ctx context.Context, deploymentID int, status DeploymentStatus, accept string) (*DeploymentStatus, *Response, error) {
+	if err := status.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	body := struct {
+		State       string `json:"state"`
+		Description string `json:"description,omitempty"`
+		Environment string `json:"environment,omitempty"`
+		TargetURL   string `json:"target_url,omitempty"`
+		LogURL      string `json:"log_url,omitempty"`
+	}{
+		State:       status.State,
+		Description: status.Description,
+		Environment: status.Environment,
+		TargetURL:   status.TargetURL,
+		LogURL:      status.LogURL,
+	}
+
+	url := fmt.Sprintf("/repos/%s/%s/deployments/%d/statuses", s.owner, s.repo, deploymentID)
+	req, err := s.client.NewRequestWithMedia(ctx, "POST", url, body, accept)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(DeploymentStatus)
+
+	resp, err := s.client.Do(req, created)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return created, resp, nil
+}
+
+// TrafficViews retrieves the number of views of a given repository in the last 14 days.
+// per is the time frame for breaking down the views and can be either day or week (default is day).
+// Traffic data is only available to users with push access to the repository (see EnsureScopes).
+// See https://docs.github.com/rest/reference/repos#get-page-views
+func (s *RepoService) TrafficViews(ctx context.Context, per string) (*Traffic, *Response, error) {
+	return s.traffic(ctx, "views", per)
+}
+
+// TrafficClones retrieves the number of clones of a given repository in the last 14 days.
+// per is the time frame for breaking down the clones and can be either day or week (default is day).
+// Traffic data is only available to users with push access to the repository (see EnsureScopes).
+// See https://docs.github.com/rest/reference/repos#get-repository-clones
+func (s *RepoService) TrafficClones(ctx context.Context, per string) (*Traffic, *Response, error) {
+	return s.traffic(ctx, "clones", per)
+}
+
+func (s *RepoService) traffic(ctx context.Context, kind, per string) (*Traffic, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/traffic/%s", s.owner, s.repo, kind)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if per != "" {
+		q := req.URL.Query()
+		q.Add("per", per)
+		req.URL.RawQuery = q.Encode()
+	}
+
+	traffic := new(Traffic)
+
+	resp, err := s.client.Do(req, traffic)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return traffic, resp, nil
+}
+
 // DownloadTarArchive downloads a repository archive in tar format.
 func (s *RepoService) DownloadTarArchive(ctx context.Context, ref string, w io.Writer) (*Response, error) {
-	url := fmt.Sprintf("/repos/%s/%s/tarball/%s", s.owner, s.repo, ref)
+	url := fmt.Sprintf("/repos/%s/%s/tarball/%s", s.owner, s.repo, url.PathEscape(ref))
 	req, err := s.client.NewRequest(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, err
@@ -634,7 +2906,7 @@ func (s *RepoService) DownloadTarArchive(ctx context.Context, ref string, w io.W
 
 // DownloadZipArchive downloads a repository archive in zip format.
 func (s *RepoService) DownloadZipArchive(ctx context.Context, ref string, w io.Writer) (*Response, error) {
-	url := fmt.Sprintf("/repos/%s/%s/zipball/%s", s.owner, s.repo, ref)
+	url := fmt.Sprintf("/repos/%s/%s/zipball/%s", s.owner, s.repo, url.PathEscape(ref))
 	req, err := s.client.NewRequest(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, err
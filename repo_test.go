@@ -1,11 +1,18 @@
 package github
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -33,6 +40,13 @@ const (
 		"archived": false,
 		"disabled": false,
 		"visibility": "public",
+		"permissions": {
+			"admin": false,
+			"maintain": false,
+			"push": true,
+			"triage": true,
+			"pull": true
+		},
 		"pushed_at": "2020-10-31T14:00:00Z",
 		"created_at": "2020-01-20T09:00:00Z",
 		"updated_at": "2020-10-31T14:00:00Z"
@@ -209,6 +223,43 @@ const (
 		}
 	]`
 
+	issueBody = `{
+		"id": 2,
+		"url": "https://api.github.com/repos/octocat/Hello-World/issues/1002",
+		"html_url": "https://github.com/octocat/Hello-World/pull/1002",
+		"number": 1002,
+		"state": "closed",
+		"title": "Fixed a bug",
+		"body": "I made this to work as expected!",
+		"user": {
+			"login": "octodog",
+			"id": 2,
+			"url": "https://api.github.com/users/octodog",
+			"html_url": "https://github.com/octodog",
+			"type": "User"
+		},
+		"labels": [
+			{
+				"id": 2000,
+				"name": "bug",
+				"default": true
+			}
+		],
+		"milestone": {
+			"id": 3000,
+			"number": 1,
+			"state": "open",
+			"title": "v1.0"
+		},
+		"locked": false,
+		"pull_request": {
+			"url": "https://api.github.com/repos/octocat/Hello-World/pulls/1002"
+		},
+		"closed_at": "2020-10-20T20:00:00Z",
+		"created_at": "2020-10-15T15:00:00Z",
+		"updated_at": "2020-10-22T22:00:00Z"
+	}`
+
 	issuesBody = `[
 		{
 			"id": 2,
@@ -470,308 +521,8741 @@ const (
 			"type": "User"
 		}
 	}`
-)
 
-var (
-	repository = Repository{
-		ID:            1296269,
-		Name:          "Hello-World",
-		FullName:      "octocat/Hello-World",
-		Description:   "This your first repo!",
-		Topics:        []string{"octocat", "api"},
-		Private:       false,
-		Fork:          false,
-		Archived:      false,
-		Disabled:      false,
-		DefaultBranch: "main",
-		Owner: User{
-			ID:    1,
-			Login: "octocat",
-			Type:  "User",
+	pullFilesBody = `[
+		{
+			"sha": "bbcd538c8e72b8c175046e27cc8f907076331401",
+			"filename": "file1.txt",
+			"status": "added",
+			"additions": 103,
+			"deletions": 21,
+			"changes": 124,
+			"blob_url": "https://github.com/octocat/Hello-World/blob/6dcb09b5b57875f334f61aebed695e2e4193db5e/file1.txt",
+			"raw_url": "https://github.com/octocat/Hello-World/raw/6dcb09b5b57875f334f61aebed695e2e4193db5e/file1.txt",
+			"contents_url": "https://api.github.com/repos/octocat/Hello-World/contents/file1.txt?ref=6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			"patch": "@@ -132,7 +132,7 @@ module Test @@ -1000,7 +1000,7 @@ module Test"
 		},
-		CreatedAt: parseGitHubTime("2020-01-20T09:00:00Z"),
-		UpdatedAt: parseGitHubTime("2020-10-31T14:00:00Z"),
-		PushedAt:  parseGitHubTime("2020-10-31T14:00:00Z"),
-	}
-
-	permission = PermissionAdmin
+		{
+			"sha": "f9a8c5e3ba0e2b6d4d7c2ad4f3f0e1e9f2b1a3c4",
+			"filename": "README.md",
+			"status": "modified",
+			"additions": 1,
+			"deletions": 1,
+			"changes": 2,
+			"patch": "@@ -1 +1 @@\n-Hello\n+Hello World"
+		}
+	]`
 
-	commit1 = Commit{
-		SHA: "6dcb09b5b57875f334f61aebed695e2e4193db5e",
-		Commit: RawCommit{
-			Message: "Fix all the bugs",
-			Author: Signature{
-				Name:  "The Octocat",
-				Email: "octocat@github.com",
-				Time:  parseGitHubTime("2020-10-20T19:59:59Z"),
-			},
-			Committer: Signature{
-				Name:  "The Octocat",
-				Email: "octocat@github.com",
-				Time:  parseGitHubTime("2020-10-20T19:59:59Z"),
+	reviewsBody = `[
+		{
+			"id": 80,
+			"user": {
+				"login": "octodog",
+				"id": 2,
+				"type": "User"
 			},
+			"body": "Looks good to me!",
+			"state": "APPROVED",
+			"html_url": "https://github.com/octocat/Hello-World/pull/1002#pullrequestreview-80",
+			"commit_id": "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			"submitted_at": "2020-10-18T12:00:00Z"
 		},
-		Author: User{
-			ID:    1,
-			Login: "octocat",
-			Type:  "User",
-		},
-		Committer: User{
-			ID:    1,
-			Login: "octocat",
-			Type:  "User",
-		},
-		Parents: []Hash{
-			{
-				SHA: "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
-				URL: "https://api.github.com/repos/octocat/Hello-World/commits/c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+		{
+			"id": 81,
+			"user": {
+				"login": "octofox",
+				"id": 3,
+				"type": "User"
 			},
-		},
-	}
+			"body": "Please add tests.",
+			"state": "CHANGES_REQUESTED",
+			"html_url": "https://github.com/octocat/Hello-World/pull/1002#pullrequestreview-81",
+			"commit_id": "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			"submitted_at": "2020-10-19T12:00:00Z"
+		}
+	]`
 
-	commit2 = Commit{
-		SHA: "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
-		Commit: RawCommit{
-			Message: "Release v0.1.0",
-			Author: Signature{
-				Name:  "The Octocat",
-				Email: "octocat@github.com",
-				Time:  parseGitHubTime("2020-10-27T23:59:59Z"),
+	mixedEmailCommitsBody = `[
+		{
+			"sha": "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+			"commit": {
+				"author": {
+					"name": "The Octocat",
+					"email": "octocat@github.com",
+					"date": "2020-10-27T23:59:59Z"
+				},
+				"committer": {
+					"name": "The Octocat",
+					"email": "octocat@github.com",
+					"date": "2020-10-27T23:59:59Z"
+				},
+				"message": "Release v0.1.0"
 			},
-			Committer: Signature{
-				Name:  "The Octocat",
-				Email: "octocat@github.com",
-				Time:  parseGitHubTime("2020-10-27T23:59:59Z"),
+			"author": {
+				"login": "octocat",
+				"id": 1,
+				"type": "User"
 			},
+			"committer": {
+				"login": "octocat",
+				"id": 1,
+				"type": "User"
+			}
 		},
-		Author: User{
-			ID:    1,
-			Login: "octocat",
-			Type:  "User",
-		},
-		Committer: User{
-			ID:    1,
-			Login: "octocat",
-			Type:  "User",
-		},
-	}
-
-	branch = Branch{
-		Name:      "main",
-		Protected: true,
-		Commit:    commit2,
-	}
+		{
+			"sha": "e5bd3914e2e596debea16f433f57875b5b90bcd6",
+			"commit": {
+				"author": {
+					"name": "The Octodog",
+					"email": "octodog@github.com",
+					"date": "2020-10-25T12:00:00Z"
+				},
+				"committer": {
+					"name": "The Octodog",
+					"email": "octodog@github.com",
+					"date": "2020-10-25T12:00:00Z"
+				},
+				"message": "Add feature"
+			},
+			"author": {
+				"login": "octodog",
+				"id": 2,
+				"type": "User"
+			},
+			"committer": {
+				"login": "octodog",
+				"id": 2,
+				"type": "User"
+			}
+		}
+	]`
 
-	tag = Tag{
-		Name: "v0.1.0",
-		Commit: Hash{
-			SHA: "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
-			URL: "https://api.github.com/repos/octocat/Hello-World/commits/c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+	reviewBody = `{
+		"id": 80,
+		"user": {
+			"login": "octodog",
+			"id": 2,
+			"type": "User"
 		},
-	}
+		"body": "Looks good to me!",
+		"state": "APPROVED",
+		"html_url": "https://github.com/octocat/Hello-World/pull/1002#pullrequestreview-80",
+		"commit_id": "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		"submitted_at": "2020-10-18T12:00:00Z"
+	}`
 
-	issue1 = Issue{
-		ID:     1,
-		Number: 1001,
-		State:  "open",
-		Locked: true,
-		Title:  "Found a bug",
-		Body:   "This is not working as expected!",
-		User: User{
-			ID:      1,
-			Login:   "octocat",
-			Type:    "User",
-			URL:     "https://api.github.com/users/octocat",
-			HTMLURL: "https://github.com/octocat",
-		},
-		Labels: []Label{
-			{
-				ID:      2000,
-				Name:    "bug",
-				Default: true,
+	comparisonBody = `{
+		"url": "https://api.github.com/repos/octocat/Hello-World/compare/v0.1.0...main",
+		"html_url": "https://github.com/octocat/Hello-World/compare/v0.1.0...main",
+		"diff_url": "https://github.com/octocat/Hello-World/compare/v0.1.0...main.diff",
+		"patch_url": "https://github.com/octocat/Hello-World/compare/v0.1.0...main.patch",
+		"status": "ahead",
+		"ahead_by": 1,
+		"behind_by": 0,
+		"total_commits": 1,
+		"base_commit": {
+			"sha": "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+			"commit": {
+				"author": {
+					"name": "The Octocat",
+					"email": "octocat@github.com",
+					"date": "2020-10-27T23:59:59Z"
+				},
+				"committer": {
+					"name": "The Octocat",
+					"email": "octocat@github.com",
+					"date": "2020-10-27T23:59:59Z"
+				},
+				"message": "Release v0.1.0"
+			},
+			"author": {
+				"login": "octocat",
+				"id": 1,
+				"type": "User"
 			},
+			"committer": {
+				"login": "octocat",
+				"id": 1,
+				"type": "User"
+			}
 		},
-		Milestone: &Milestone{
-			ID:     3000,
-			Number: 1,
-			State:  "open",
-			Title:  "v1.0",
+		"merge_base_commit": {
+			"sha": "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+			"commit": {
+				"author": {
+					"name": "The Octocat",
+					"email": "octocat@github.com",
+					"date": "2020-10-27T23:59:59Z"
+				},
+				"committer": {
+					"name": "The Octocat",
+					"email": "octocat@github.com",
+					"date": "2020-10-27T23:59:59Z"
+				},
+				"message": "Release v0.1.0"
+			},
+			"author": {
+				"login": "octocat",
+				"id": 1,
+				"type": "User"
+			},
+			"committer": {
+				"login": "octocat",
+				"id": 1,
+				"type": "User"
+			}
 		},
-		URL:       "https://api.github.com/repos/octocat/Hello-World/issues/1001",
-		HTMLURL:   "https://github.com/octocat/Hello-World/issues/1001",
+		"commits": [
+			{
+				"sha": "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+				"commit": {
+					"author": {
+						"name": "The Octocat",
+						"email": "octocat@github.com",
+						"date": "2020-10-20T19:59:59Z"
+					},
+					"committer": {
+						"name": "The Octocat",
+						"email": "octocat@github.com",
+						"date": "2020-10-20T19:59:59Z"
+					},
+					"message": "Fix all the bugs"
+				},
+				"author": {
+					"login": "octocat",
+					"id": 1,
+					"type": "User"
+				},
+				"committer": {
+					"login": "octocat",
+					"id": 1,
+					"type": "User"
+				},
+				"parents": [
+					{
+						"url": "https://api.github.com/repos/octocat/Hello-World/commits/c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+						"sha": "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c"
+					}
+				]
+			}
+		],
+		"files": [
+			{
+				"sha": "bbcd538c8e72b8c175046e27cc8f907076331401",
+				"filename": "file1.txt",
+				"status": "added",
+				"additions": 103,
+				"deletions": 21,
+				"changes": 124,
+				"blob_url": "https://github.com/octocat/Hello-World/blob/6dcb09b5b57875f334f61aebed695e2e4193db5e/file1.txt",
+				"raw_url": "https://github.com/octocat/Hello-World/raw/6dcb09b5b57875f334f61aebed695e2e4193db5e/file1.txt",
+				"contents_url": "https://api.github.com/repos/octocat/Hello-World/contents/file1.txt?ref=6dcb09b5b57875f334f61aebed695e2e4193db5e",
+				"patch": "@@ -132,7 +132,7 @@ module Test @@ -1000,7 +1000,7 @@ module Test"
+			},
+			{
+				"sha": "f9a8c5e3ba0e2b6d4d7c2ad4f3f0e1e9f2b1a3c4",
+				"filename": "README.md",
+				"status": "modified",
+				"additions": 1,
+				"deletions": 1,
+				"changes": 2,
+				"patch": "@@ -1 +1 @@\n-Hello\n+Hello World"
+			}
+		]
+	}`
+
+	invitationsBody = `[
+		{
+			"id": 1,
+			"repository": {
+				"id": 1296269,
+				"name": "Hello-World",
+				"full_name": "octocat/Hello-World",
+				"owner": {
+					"login": "octocat",
+					"id": 1,
+					"type": "User"
+				}
+			},
+			"invitee": {
+				"login": "octodog",
+				"id": 2,
+				"type": "User"
+			},
+			"inviter": {
+				"login": "octocat",
+				"id": 1,
+				"type": "User"
+			},
+			"permissions": "write",
+			"expired": false,
+			"url": "https://api.github.com/user/repository-invitations/1",
+			"html_url": "https://github.com/octocat/Hello-World/invitations",
+			"created_at": "2020-10-10T10:00:00Z"
+		}
+	]`
+
+	contributorsBody = `[
+		{
+			"login": "octocat",
+			"id": 1,
+			"type": "User",
+			"contributions": 32
+		},
+		{
+			"type": "Anonymous",
+			"name": "The Octobot",
+			"email": "octobot@github.com",
+			"contributions": 3
+		}
+	]`
+
+	languagesBody = `{
+		"Go": 7500,
+		"Makefile": 1500,
+		"Shell": 1000
+	}`
+
+	topicsBody = `{
+		"names": [
+			"octocat",
+			"api"
+		]
+	}`
+
+	fileContentBody = `{
+		"type": "file",
+		"encoding": "base64",
+		"size": 7,
+		"name": "VERSION",
+		"path": "VERSION",
+		"content": "djEuMC4w\nCg==\n",
+		"sha": "3d21ec53a331a6f037a91c368710b99387d012c1",
+		"url": "https://api.github.com/repos/octocat/Hello-World/contents/VERSION",
+		"html_url": "https://github.com/octocat/Hello-World/blob/main/VERSION",
+		"download_url": "https://raw.githubusercontent.com/octocat/Hello-World/main/VERSION"
+	}`
+
+	dirContentBody = `[
+		{
+			"type": "file",
+			"size": 7,
+			"name": "VERSION",
+			"path": "VERSION",
+			"sha": "3d21ec53a331a6f037a91c368710b99387d012c1",
+			"url": "https://api.github.com/repos/octocat/Hello-World/contents/VERSION",
+			"html_url": "https://github.com/octocat/Hello-World/blob/main/VERSION",
+			"download_url": "https://raw.githubusercontent.com/octocat/Hello-World/main/VERSION"
+		},
+		{
+			"type": "dir",
+			"size": 0,
+			"name": "docs",
+			"path": "docs",
+			"sha": "a84d88e7554fc1fa21bcbc4efae3c782a70d2b9d",
+			"url": "https://api.github.com/repos/octocat/Hello-World/contents/docs",
+			"html_url": "https://github.com/octocat/Hello-World/tree/main/docs",
+			"download_url": null
+		}
+	]`
+
+	statusesBody = `[
+		{
+			"id": 1,
+			"url": "https://api.github.com/repos/octocat/Hello-World/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			"state": "success",
+			"description": "Build has completed successfully",
+			"target_url": "https://ci.example.com/1000/output",
+			"context": "continuous-integration/jenkins",
+			"creator": {
+				"login": "octocat",
+				"id": 1,
+				"url": "https://api.github.com/users/octocat",
+				"html_url": "https://github.com/octocat",
+				"type": "User"
+			},
+			"created_at": "2020-10-20T20:00:00Z",
+			"updated_at": "2020-10-20T20:00:00Z"
+		}
+	]`
+
+	statusBody = `{
+		"id": 1,
+		"url": "https://api.github.com/repos/octocat/Hello-World/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		"state": "success",
+		"description": "Build has completed successfully",
+		"target_url": "https://ci.example.com/1000/output",
+		"context": "continuous-integration/jenkins",
+		"creator": {
+			"login": "octocat",
+			"id": 1,
+			"url": "https://api.github.com/users/octocat",
+			"html_url": "https://github.com/octocat",
+			"type": "User"
+		},
+		"created_at": "2020-10-20T20:00:00Z",
+		"updated_at": "2020-10-20T20:00:00Z"
+	}`
+
+	combinedStatusBody = `{
+		"state": "success",
+		"total_count": 2,
+		"sha": "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		"statuses": [
+			{
+				"id": 1,
+				"url": "https://api.github.com/repos/octocat/Hello-World/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e",
+				"state": "success",
+				"description": "Build has completed successfully",
+				"target_url": "https://ci.example.com/1000/output",
+				"context": "continuous-integration/jenkins",
+				"creator": {
+					"login": "octocat",
+					"id": 1,
+					"url": "https://api.github.com/users/octocat",
+					"html_url": "https://github.com/octocat",
+					"type": "User"
+				},
+				"created_at": "2020-10-20T20:00:00Z",
+				"updated_at": "2020-10-20T20:00:00Z"
+			},
+			{
+				"id": 2,
+				"url": "https://api.github.com/repos/octocat/Hello-World/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e",
+				"state": "success",
+				"description": "Code coverage is 90%",
+				"target_url": "https://coverage.example.com/1000",
+				"context": "coverage",
+				"creator": {
+					"login": "octocat",
+					"id": 1,
+					"url": "https://api.github.com/users/octocat",
+					"html_url": "https://github.com/octocat",
+					"type": "User"
+				},
+				"created_at": "2020-10-20T20:10:00Z",
+				"updated_at": "2020-10-20T20:10:00Z"
+			}
+		]
+	}`
+
+	checkRunsBody = `{
+		"total_count": 1,
+		"check_runs": [
+			{
+				"id": 4,
+				"head_sha": "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+				"html_url": "https://github.com/octocat/Hello-World/runs/4",
+				"status": "completed",
+				"conclusion": "success",
+				"started_at": "2020-10-20T20:00:00Z",
+				"completed_at": "2020-10-20T20:05:00Z",
+				"name": "build"
+			}
+		]
+	}`
+
+	milestonesBody = `[
+		{
+			"id": 3000,
+			"number": 1,
+			"state": "open",
+			"title": "v1.0",
+			"description": "Tracking milestone for version 1.0",
+			"creator": {
+				"login": "octocat",
+				"id": 1,
+				"url": "https://api.github.com/users/octocat",
+				"html_url": "https://github.com/octocat",
+				"type": "User"
+			},
+			"open_issues": 4,
+			"closed_issues": 8,
+			"due_on": "2020-12-31T00:00:00Z",
+			"url": "https://api.github.com/repos/octocat/Hello-World/milestones/1",
+			"html_url": "https://github.com/octocat/Hello-World/milestones/v1.0",
+			"labels_url": "https://api.github.com/repos/octocat/Hello-World/milestones/1/labels",
+			"created_at": "2020-10-01T10:00:00Z",
+			"updated_at": "2020-10-20T20:00:00Z",
+			"closed_at": null
+		}
+	]`
+
+	milestoneBody = `{
+		"id": 3000,
+		"number": 1,
+		"state": "open",
+		"title": "v1.0",
+		"description": "Tracking milestone for version 1.0",
+		"creator": {
+			"login": "octocat",
+			"id": 1,
+			"url": "https://api.github.com/users/octocat",
+			"html_url": "https://github.com/octocat",
+			"type": "User"
+		},
+		"open_issues": 4,
+		"closed_issues": 8,
+		"due_on": "2020-12-31T00:00:00Z",
+		"url": "https://api.github.com/repos/octocat/Hello-World/milestones/1",
+		"html_url": "https://github.com/octocat/Hello-World/milestones/v1.0",
+		"labels_url": "https://api.github.com/repos/octocat/Hello-World/milestones/1/labels",
+		"created_at": "2020-10-01T10:00:00Z",
+		"updated_at": "2020-10-20T20:00:00Z",
+		"closed_at": null
+	}`
+
+	hookDeliveriesBody = `[
+		{
+			"id": 12345678,
+			"guid": "0b989ba4-242f-11e5-81e1-c7b6966d2516",
+			"delivered_at": "2020-10-20T20:00:00Z",
+			"redelivery": false,
+			"duration": 0.27,
+			"status": "OK",
+			"status_code": 200,
+			"event": "issues",
+			"action": "opened"
+		},
+		{
+			"id": 12345679,
+			"guid": "1c989ba4-242f-11e5-81e1-c7b6966d2516",
+			"delivered_at": "2020-10-20T21:00:00Z",
+			"redelivery": true,
+			"redelivery_of": 12345678,
+			"duration": 0.31,
+			"status": "OK",
+			"status_code": 200,
+			"event": "issues",
+			"action": "opened"
+		}
+	]`
+
+	webhooksBody = `[
+		{
+			"id": 1,
+			"name": "web",
+			"active": true,
+			"events": [
+				"push",
+				"pull_request"
+			],
+			"config": {
+				"url": "https://example.com/webhook",
+				"content_type": "json",
+				"insecure_ssl": "0"
+			},
+			"url": "https://api.github.com/repos/octocat/Hello-World/hooks/1",
+			"created_at": "2020-10-01T10:00:00Z",
+			"updated_at": "2020-10-20T20:00:00Z"
+		}
+	]`
+
+	webhookBody = `{
+		"id": 1,
+		"name": "web",
+		"active": true,
+		"events": [
+			"push",
+			"pull_request"
+		],
+		"config": {
+			"url": "https://example.com/webhook",
+			"content_type": "json",
+			"insecure_ssl": "0"
+		},
+		"url": "https://api.github.com/repos/octocat/Hello-World/hooks/1",
+		"created_at": "2020-10-01T10:00:00Z",
+		"updated_at": "2020-10-20T20:00:00Z"
+	}`
+
+	customRolePermissionBody = `{
+		"permission": "write",
+		"role_name": "security-reviewer",
+		"user": {
+			"login": "octocat",
+			"id": 1,
+			"type": "User"
+		}
+	}`
+
+	workflowRunBody = `{
+		"id": 30433642,
+		"name": "Build",
+		"workflow_id": 159038,
+		"run_number": 562,
+		"event": "push",
+		"status": "completed",
+		"conclusion": "success",
+		"head_branch": "main",
+		"head_sha": "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		"url": "https://api.github.com/repos/octocat/Hello-World/actions/runs/30433642",
+		"html_url": "https://github.com/octocat/Hello-World/actions/runs/30433642",
+		"created_at": "2020-10-20T20:00:00Z",
+		"updated_at": "2020-10-20T20:05:00Z"
+	}`
+
+	activityBody = `[
+		{
+			"id": 1296269,
+			"node_id": "MDEwOlJlcG9zaXRvcnkxMjk2MjY5",
+			"before": "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			"after": "827efc6d56897b048c772eb4087f854f46256132",
+			"ref": "refs/heads/main",
+			"timestamp": "2020-10-20T20:00:00Z",
+			"activity_type": "force_push",
+			"actor": {
+				"login": "octocat",
+				"id": 1,
+				"url": "https://api.github.com/users/octocat",
+				"html_url": "https://github.com/octocat",
+				"type": "User"
+			}
+		}
+	]`
+
+	deploymentBody = `{
+		"id": 1,
+		"sha": "a84d88e7554fc1fa21bcbc4efae3c782a70d2b9d",
+		"ref": "topic-branch",
+		"task": "deploy",
+		"environment": "production",
+		"description": "Deploy request from hubot",
+		"creator": {
+			"login": "octocat",
+			"id": 1,
+			"type": "User"
+		},
+		"created_at": "2020-10-20T20:00:00Z"
+	}`
+
+	deploymentsBody = `[
+		{
+			"id": 1,
+			"sha": "a84d88e7554fc1fa21bcbc4efae3c782a70d2b9d",
+			"ref": "topic-branch",
+			"task": "deploy",
+			"environment": "production",
+			"description": "Deploy request from hubot",
+			"creator": {
+				"login": "octocat",
+				"id": 1,
+				"type": "User"
+			},
+			"created_at": "2020-10-20T20:00:00Z"
+		}
+	]`
+
+	gitTagBody = `{
+		"tag": "v1.0.0",
+		"sha": "940bd336248efae0f9ee5bc7b2d5c985887b16ac",
+		"url": "https://api.github.com/repos/octocat/Hello-World/git/tags/940bd336248efae0f9ee5bc7b2d5c985887b16ac",
+		"message": "Release v1.0.0",
+		"tagger": {
+			"name": "Monalisa Octocat",
+			"email": "octocat@github.com",
+			"date": "2020-10-20T20:00:00Z"
+		},
+		"object": {
+			"type": "commit",
+			"sha": "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+			"url": "https://api.github.com/repos/octocat/Hello-World/git/commits/c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c"
+		}
+	}`
+
+	branchProtectionBody = `{
+		"url": "https://api.github.com/repos/octocat/Hello-World/branches/main/protection",
+		"required_status_checks": {
+			"strict": true,
+			"contexts": [
+				"continuous-integration/travis-ci"
+			]
+		},
+		"enforce_admins": {
+			"enabled": true
+		},
+		"required_pull_request_reviews": {
+			"dismiss_stale_reviews": true,
+			"require_code_owner_reviews": true,
+			"required_approving_review_count": 2
+		},
+		"restrictions": {
+			"users": [
+				{
+					"login": "octocat",
+					"id": 1,
+					"type": "User"
+				}
+			],
+			"teams": [
+				{
+					"id": 1,
+					"name": "Justice League",
+					"slug": "justice-league"
+				}
+			]
+		}
+	}`
+)
+
+var (
+	repository = Repository{
+		ID:            1296269,
+		Name:          "Hello-World",
+		FullName:      "octocat/Hello-World",
+		Description:   "This your first repo!",
+		Topics:        []string{"octocat", "api"},
+		Private:       false,
+		Fork:          false,
+		Archived:      false,
+		Disabled:      false,
+		DefaultBranch: "main",
+		Owner: User{
+			ID:    1,
+			Login: "octocat",
+			Type:  "User",
+		},
+		Permissions: &RepoPermissions{
+			Push:   true,
+			Triage: true,
+			Pull:   true,
+		},
+		CreatedAt: parseGitHubTime("2020-01-20T09:00:00Z"),
+		UpdatedAt: parseGitHubTime("2020-10-31T14:00:00Z"),
+		PushedAt:  parseGitHubTime("2020-10-31T14:00:00Z"),
+	}
+
+	permission = PermissionAdmin
+
+	commit1 = Commit{
+		SHA: "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		Commit: RawCommit{
+			Message: "Fix all the bugs",
+			Author: Signature{
+				Name:  "The Octocat",
+				Email: "octocat@github.com",
+				Time:  parseGitHubTime("2020-10-20T19:59:59Z"),
+			},
+			Committer: Signature{
+				Name:  "The Octocat",
+				Email: "octocat@github.com",
+				Time:  parseGitHubTime("2020-10-20T19:59:59Z"),
+			},
+		},
+		Author: User{
+			ID:    1,
+			Login: "octocat",
+			Type:  "User",
+		},
+		Committer: User{
+			ID:    1,
+			Login: "octocat",
+			Type:  "User",
+		},
+		Parents: []Hash{
+			{
+				SHA: "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+				URL: "https://api.github.com/repos/octocat/Hello-World/commits/c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+			},
+		},
+	}
+
+	commit2 = Commit{
+		SHA: "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+		Commit: RawCommit{
+			Message: "Release v0.1.0",
+			Author: Signature{
+				Name:  "The Octocat",
+				Email: "octocat@github.com",
+				Time:  parseGitHubTime("2020-10-27T23:59:59Z"),
+			},
+			Committer: Signature{
+				Name:  "The Octocat",
+				Email: "octocat@github.com",
+				Time:  parseGitHubTime("2020-10-27T23:59:59Z"),
+			},
+		},
+		Author: User{
+			ID:    1,
+			Login: "octocat",
+			Type:  "User",
+		},
+		Committer: User{
+			ID:    1,
+			Login: "octocat",
+			Type:  "User",
+		},
+	}
+
+	branch = Branch{
+		Name:      "main",
+		Protected: true,
+		Commit:    commit2,
+	}
+
+	tag = Tag{
+		Name: "v0.1.0",
+		Commit: Hash{
+			SHA: "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+			URL: "https://api.github.com/repos/octocat/Hello-World/commits/c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+		},
+	}
+
+	issue1 = Issue{
+		ID:     1,
+		Number: 1001,
+		State:  "open",
+		Locked: true,
+		Title:  "Found a bug",
+		Body:   "This is not working as expected!",
+		User: User{
+			ID:      1,
+			Login:   "octocat",
+			Type:    "User",
+			URL:     "https://api.github.com/users/octocat",
+			HTMLURL: "https://github.com/octocat",
+		},
+		Labels: []Label{
+			{
+				ID:      2000,
+				Name:    "bug",
+				Default: true,
+			},
+		},
+		Milestone: &Milestone{
+			ID:     3000,
+			Number: 1,
+			State:  "open",
+			Title:  "v1.0",
+		},
+		URL:       "https://api.github.com/repos/octocat/Hello-World/issues/1001",
+		HTMLURL:   "https://github.com/octocat/Hello-World/issues/1001",
 		CreatedAt: parseGitHubTime("2020-10-10T10:00:00Z"),
 		UpdatedAt: parseGitHubTime("2020-10-20T20:00:00Z"),
-		ClosedAt:  nil,
+		ClosedAt:  nil,
+	}
+
+	issue2 = Issue{
+		ID:     2,
+		Number: 1002,
+		State:  "closed",
+		Locked: false,
+		Title:  "Fixed a bug",
+		Body:   "I made this to work as expected!",
+		User: User{
+			ID:      2,
+			Login:   "octodog",
+			Type:    "User",
+			URL:     "https://api.github.com/users/octodog",
+			HTMLURL: "https://github.com/octodog",
+		},
+		Labels: []Label{
+			{
+				ID:      2000,
+				Name:    "bug",
+				Default: true,
+			},
+		},
+		Milestone: &Milestone{
+			ID:     3000,
+			Number: 1,
+			State:  "open",
+			Title:  "v1.0",
+		},
+		URL:     "https://api.github.com/repos/octocat/Hello-World/issues/1002",
+		HTMLURL: "https://github.com/octocat/Hello-World/pull/1002",
+		PullURLs: &PullURLs{
+			URL: "https://api.github.com/repos/octocat/Hello-World/pulls/1002",
+		},
+		CreatedAt: parseGitHubTime("2020-10-15T15:00:00Z"),
+		UpdatedAt: parseGitHubTime("2020-10-22T22:00:00Z"),
+		ClosedAt:  parseGitHubTimePtr("2020-10-20T20:00:00Z"),
+	}
+
+	pull = Pull{
+		ID:     1,
+		Number: 1002,
+		State:  "closed",
+		Draft:  false,
+		Locked: false,
+		Title:  "Fixed a bug",
+		Body:   "I made this to work as expected!",
+		User: User{
+			ID:      2,
+			Login:   "octodog",
+			Type:    "User",
+			URL:     "https://api.github.com/users/octodog",
+			HTMLURL: "https://github.com/octodog",
+		},
+		Labels: []Label{
+			{
+				ID:      2000,
+				Name:    "bug",
+				Default: true,
+			},
+		},
+		Milestone: &Milestone{
+			ID:     3000,
+			Number: 1,
+			State:  "open",
+			Title:  "v1.0",
+		},
+		Base: PullBranch{
+			Label: "octodog:master",
+			Ref:   "master",
+			SHA:   "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		},
+		Head: PullBranch{
+			Label: "octodog:new-topic",
+			Ref:   "new-topic",
+			SHA:   "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		},
+		Merged:     true,
+		Mergeable:  nil,
+		Rebaseable: nil,
+		MergedBy: &User{
+			ID:      3,
+			Login:   "octofox",
+			Type:    "User",
+			URL:     "https://api.github.com/users/octofox",
+			HTMLURL: "https://github.com/octofox",
+		},
+		MergeCommitSHA: "e5bd3914e2e596debea16f433f57875b5b90bcd6",
+		URL:            "https://api.github.com/repos/octocat/Hello-World/pulls/1002",
+		HTMLURL:        "https://github.com/octocat/Hello-World/pull/1002",
+		CreatedAt:      parseGitHubTime("2020-10-15T15:00:00Z"),
+		UpdatedAt:      parseGitHubTime("2020-10-22T22:00:00Z"),
+		ClosedAt:       parseGitHubTimePtr("2020-10-20T20:00:00Z"),
+		MergedAt:       parseGitHubTimePtr("2020-10-20T20:00:00Z"),
+	}
+
+	event1 = Event{
+		ID:       1,
+		Event:    "closed",
+		CommitID: "",
+		Actor: User{
+			ID:      1,
+			Login:   "octocat",
+			Type:    "User",
+			URL:     "https://api.github.com/users/octocat",
+			HTMLURL: "https://github.com/octocat",
+		},
+		CreatedAt: parseGitHubTime("2020-10-20T20:00:00Z"),
+	}
+
+	event2 = Event{
+		ID:       2,
+		Event:    "merged",
+		CommitID: "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		Actor: User{
+			ID:      3,
+			Login:   "octofox",
+			Type:    "User",
+			URL:     "https://api.github.com/users/octofox",
+			HTMLURL: "https://github.com/octofox",
+		},
+		CreatedAt: parseGitHubTime("2020-10-20T20:00:00Z"),
+	}
+
+	release = Release{
+		ID:         1,
+		Name:       "v1.0.0",
+		TagName:    "v1.0.0",
+		Target:     "main",
+		Draft:      false,
+		Prerelease: false,
+		Body:       "Description of the release",
+		Author: User{
+			ID:    1,
+			Login: "octocat",
+			Type:  "User",
+		},
+		Assets: []ReleaseAsset{
+			{
+				ID:          1,
+				Name:        "example.zip",
+				Label:       "short description",
+				State:       "uploaded",
+				ContentType: "application/zip",
+				Size:        1024,
+				Uploader: User{
+					ID:    1,
+					Login: "octocat",
+					Type:  "User",
+				},
+			},
+		},
+	}
+
+	releaseAsset = ReleaseAsset{
+		ID:          1,
+		Name:        "example.zip",
+		Label:       "short description",
+		State:       "uploaded",
+		ContentType: "application/zip",
+		Size:        1024,
+		Uploader: User{
+			ID:    1,
+			Login: "octocat",
+			Type:  "User",
+		},
+	}
+
+	pullFile1 = PullFile{
+		SHA:         "bbcd538c8e72b8c175046e27cc8f907076331401",
+		Filename:    "file1.txt",
+		Status:      "added",
+		Additions:   103,
+		Deletions:   21,
+		Changes:     124,
+		Patch:       "@@ -132,7 +132,7 @@ module Test @@ -1000,7 +1000,7 @@ module Test",
+		BlobURL:     "https://github.com/octocat/Hello-World/blob/6dcb09b5b57875f334f61aebed695e2e4193db5e/file1.txt",
+		RawURL:      "https://github.com/octocat/Hello-World/raw/6dcb09b5b57875f334f61aebed695e2e4193db5e/file1.txt",
+		ContentsURL: "https://api.github.com/repos/octocat/Hello-World/contents/file1.txt?ref=6dcb09b5b57875f334f61aebed695e2e4193db5e",
+	}
+
+	pullFile2 = PullFile{
+		SHA:       "f9a8c5e3ba0e2b6d4d7c2ad4f3f0e1e9f2b1a3c4",
+		Filename:  "README.md",
+		Status:    "modified",
+		Additions: 1,
+		Deletions: 1,
+		Changes:   2,
+		Patch:     "@@ -1 +1 @@\n-Hello\n+Hello World",
+	}
+
+	review1 = Review{
+		ID: 80,
+		User: User{
+			ID:    2,
+			Login: "octodog",
+			Type:  "User",
+		},
+		Body:        "Looks good to me!",
+		State:       "APPROVED",
+		CommitID:    "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		HTMLURL:     "https://github.com/octocat/Hello-World/pull/1002#pullrequestreview-80",
+		SubmittedAt: parseGitHubTime("2020-10-18T12:00:00Z"),
+	}
+
+	review2 = Review{
+		ID: 81,
+		User: User{
+			ID:    3,
+			Login: "octofox",
+			Type:  "User",
+		},
+		Body:        "Please add tests.",
+		State:       "CHANGES_REQUESTED",
+		CommitID:    "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		HTMLURL:     "https://github.com/octocat/Hello-World/pull/1002#pullrequestreview-81",
+		SubmittedAt: parseGitHubTime("2020-10-19T12:00:00Z"),
+	}
+
+	comparison = Comparison{
+		Status:          "ahead",
+		AheadBy:         1,
+		BehindBy:        0,
+		TotalCommits:    1,
+		BaseCommit:      commit2,
+		MergeBaseCommit: commit2,
+		Commits:         []Commit{commit1},
+		Files:           []PullFile{pullFile1, pullFile2},
+		URL:             "https://api.github.com/repos/octocat/Hello-World/compare/v0.1.0...main",
+		HTMLURL:         "https://github.com/octocat/Hello-World/compare/v0.1.0...main",
+		DiffURL:         "https://github.com/octocat/Hello-World/compare/v0.1.0...main.diff",
+		PatchURL:        "https://github.com/octocat/Hello-World/compare/v0.1.0...main.patch",
+	}
+
+	invitation = Invitation{
+		ID: 1,
+		Repository: Repository{
+			ID:       1296269,
+			Name:     "Hello-World",
+			FullName: "octocat/Hello-World",
+			Owner: User{
+				ID:    1,
+				Login: "octocat",
+				Type:  "User",
+			},
+		},
+		Invitee: User{
+			ID:    2,
+			Login: "octodog",
+			Type:  "User",
+		},
+		Inviter: User{
+			ID:    1,
+			Login: "octocat",
+			Type:  "User",
+		},
+		Permissions: "write",
+		Expired:     false,
+		URL:         "https://api.github.com/user/repository-invitations/1",
+		HTMLURL:     "https://github.com/octocat/Hello-World/invitations",
+		CreatedAt:   parseGitHubTime("2020-10-10T10:00:00Z"),
+	}
+
+	contributor1 = Contributor{
+		User: User{
+			ID:    1,
+			Login: "octocat",
+			Type:  "User",
+		},
+		Contributions: 32,
+	}
+
+	contributor2 = Contributor{
+		User: User{
+			Type:  "Anonymous",
+			Name:  "The Octobot",
+			Email: "octobot@github.com",
+		},
+		Contributions: 3,
+	}
+
+	languages = map[string]int{
+		"Go":       7500,
+		"Makefile": 1500,
+		"Shell":    1000,
+	}
+
+	fileContent = RepoContent{
+		Type:        "file",
+		Encoding:    "base64",
+		Size:        7,
+		Name:        "VERSION",
+		Path:        "VERSION",
+		Content:     "djEuMC4w\nCg==\n",
+		SHA:         "3d21ec53a331a6f037a91c368710b99387d012c1",
+		URL:         "https://api.github.com/repos/octocat/Hello-World/contents/VERSION",
+		HTMLURL:     "https://github.com/octocat/Hello-World/blob/main/VERSION",
+		DownloadURL: "https://raw.githubusercontent.com/octocat/Hello-World/main/VERSION",
+	}
+
+	dirContents = []RepoContent{
+		{
+			Type:        "file",
+			Size:        7,
+			Name:        "VERSION",
+			Path:        "VERSION",
+			SHA:         "3d21ec53a331a6f037a91c368710b99387d012c1",
+			URL:         "https://api.github.com/repos/octocat/Hello-World/contents/VERSION",
+			HTMLURL:     "https://github.com/octocat/Hello-World/blob/main/VERSION",
+			DownloadURL: "https://raw.githubusercontent.com/octocat/Hello-World/main/VERSION",
+		},
+		{
+			Type:    "dir",
+			Size:    0,
+			Name:    "docs",
+			Path:    "docs",
+			SHA:     "a84d88e7554fc1fa21bcbc4efae3c782a70d2b9d",
+			URL:     "https://api.github.com/repos/octocat/Hello-World/contents/docs",
+			HTMLURL: "https://github.com/octocat/Hello-World/tree/main/docs",
+		},
+	}
+
+	status = Status{
+		ID:          1,
+		State:       "success",
+		TargetURL:   "https://ci.example.com/1000/output",
+		Description: "Build has completed successfully",
+		Context:     "continuous-integration/jenkins",
+		Creator: User{
+			ID:      1,
+			Login:   "octocat",
+			Type:    "User",
+			URL:     "https://api.github.com/users/octocat",
+			HTMLURL: "https://github.com/octocat",
+		},
+		URL:       "https://api.github.com/repos/octocat/Hello-World/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		CreatedAt: parseGitHubTime("2020-10-20T20:00:00Z"),
+		UpdatedAt: parseGitHubTime("2020-10-20T20:00:00Z"),
+	}
+
+	combinedStatus = CombinedStatus{
+		State:      "success",
+		TotalCount: 2,
+		SHA:        "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		Statuses: []Status{
+			status,
+			{
+				ID:          2,
+				State:       "success",
+				TargetURL:   "https://coverage.example.com/1000",
+				Description: "Code coverage is 90%",
+				Context:     "coverage",
+				Creator: User{
+					ID:      1,
+					Login:   "octocat",
+					Type:    "User",
+					URL:     "https://api.github.com/users/octocat",
+					HTMLURL: "https://github.com/octocat",
+				},
+				URL:       "https://api.github.com/repos/octocat/Hello-World/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e",
+				CreatedAt: parseGitHubTime("2020-10-20T20:10:00Z"),
+				UpdatedAt: parseGitHubTime("2020-10-20T20:10:00Z"),
+			},
+		},
+	}
+
+	checkRun = CheckRun{
+		ID:          4,
+		Name:        "build",
+		Status:      "completed",
+		Conclusion:  "success",
+		HeadSHA:     "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		HTMLURL:     "https://github.com/octocat/Hello-World/runs/4",
+		StartedAt:   parseGitHubTime("2020-10-20T20:00:00Z"),
+		CompletedAt: parseGitHubTimePtr("2020-10-20T20:05:00Z"),
+	}
+
+	milestone = Milestone{
+		ID:          3000,
+		Number:      1,
+		State:       "open",
+		Title:       "v1.0",
+		Description: "Tracking milestone for version 1.0",
+		Creator: User{
+			ID:      1,
+			Login:   "octocat",
+			Type:    "User",
+			URL:     "https://api.github.com/users/octocat",
+			HTMLURL: "https://github.com/octocat",
+		},
+		OpenIssues:   4,
+		ClosedIssues: 8,
+		DueOn:        parseGitHubTimePtr("2020-12-31T00:00:00Z"),
+		URL:          "https://api.github.com/repos/octocat/Hello-World/milestones/1",
+		HTMLURL:      "https://github.com/octocat/Hello-World/milestones/v1.0",
+		LabelsURL:    "https://api.github.com/repos/octocat/Hello-World/milestones/1/labels",
+		CreatedAt:    parseGitHubTime("2020-10-01T10:00:00Z"),
+		UpdatedAt:    parseGitHubTime("2020-10-20T20:00:00Z"),
+	}
+
+	hookDelivery = HookDelivery{
+		ID:          12345678,
+		GUID:        "0b989ba4-242f-11e5-81e1-c7b6966d2516",
+		Event:       "issues",
+		Action:      "opened",
+		Status:      "OK",
+		StatusCode:  200,
+		Duration:    0.27,
+		Redelivery:  false,
+		DeliveredAt: parseGitHubTime("2020-10-20T20:00:00Z"),
+	}
+
+	hookRedelivery = HookDelivery{
+		ID:           12345679,
+		GUID:         "1c989ba4-242f-11e5-81e1-c7b6966d2516",
+		Event:        "issues",
+		Action:       "opened",
+		Status:       "OK",
+		StatusCode:   200,
+		Duration:     0.31,
+		Redelivery:   true,
+		RedeliveryOf: 12345678,
+		DeliveredAt:  parseGitHubTime("2020-10-20T21:00:00Z"),
+	}
+
+	webhook = Webhook{
+		ID:     1,
+		Name:   "web",
+		Active: true,
+		Events: []string{"push", "pull_request"},
+		Config: map[string]string{
+			"url":          "https://example.com/webhook",
+			"content_type": "json",
+			"insecure_ssl": "0",
+		},
+		URL:       "https://api.github.com/repos/octocat/Hello-World/hooks/1",
+		CreatedAt: parseGitHubTime("2020-10-01T10:00:00Z"),
+		UpdatedAt: parseGitHubTime("2020-10-20T20:00:00Z"),
+	}
+
+	customRolePermission = CollaboratorPermission{
+		Permission: PermissionWrite,
+		RoleName:   "security-reviewer",
+		User: User{
+			ID:    1,
+			Login: "octocat",
+			Type:  "User",
+		},
+	}
+
+	workflowRun = WorkflowRun{
+		ID:         30433642,
+		Name:       "Build",
+		WorkflowID: 159038,
+		RunNumber:  562,
+		Event:      "push",
+		Status:     "completed",
+		Conclusion: "success",
+		HeadBranch: "main",
+		HeadSHA:    "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		URL:        "https://api.github.com/repos/octocat/Hello-World/actions/runs/30433642",
+		HTMLURL:    "https://github.com/octocat/Hello-World/actions/runs/30433642",
+		CreatedAt:  parseGitHubTime("2020-10-20T20:00:00Z"),
+		UpdatedAt:  parseGitHubTime("2020-10-20T20:05:00Z"),
+	}
+
+	activity = RepoActivity{
+		ID:           1296269,
+		Ref:          "refs/heads/main",
+		Before:       "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		After:        "827efc6d56897b048c772eb4087f854f46256132",
+		Timestamp:    parseGitHubTime("2020-10-20T20:00:00Z"),
+		ActivityType: "force_push",
+		Actor: User{
+			ID:      1,
+			Login:   "octocat",
+			Type:    "User",
+			URL:     "https://api.github.com/users/octocat",
+			HTMLURL: "https://github.com/octocat",
+		},
+	}
+
+	deployment = Deployment{
+		ID:          1,
+		SHA:         "a84d88e7554fc1fa21bcbc4efae3c782a70d2b9d",
+		Ref:         "topic-branch",
+		Task:        "deploy",
+		Environment: "production",
+		Description: "Deploy request from hubot",
+		Creator: User{
+			ID:    1,
+			Login: "octocat",
+			Type:  "User",
+		},
+		CreatedAt: parseGitHubTime("2020-10-20T20:00:00Z"),
+	}
+
+	gitTag = GitTag{
+		Tag:     "v1.0.0",
+		SHA:     "940bd336248efae0f9ee5bc7b2d5c985887b16ac",
+		URL:     "https://api.github.com/repos/octocat/Hello-World/git/tags/940bd336248efae0f9ee5bc7b2d5c985887b16ac",
+		Message: "Release v1.0.0",
+		Tagger: Signature{
+			Name:  "Monalisa Octocat",
+			Email: "octocat@github.com",
+			Time:  parseGitHubTime("2020-10-20T20:00:00Z"),
+		},
+		Object: Hash{
+			SHA: "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+			URL: "https://api.github.com/repos/octocat/Hello-World/git/commits/c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+		},
+	}
+
+	branchProtection = BranchProtection{
+		URL: "https://api.github.com/repos/octocat/Hello-World/branches/main/protection",
+		RequiredStatusChecks: &RequiredStatusChecks{
+			Strict:   true,
+			Contexts: []string{"continuous-integration/travis-ci"},
+		},
+		EnforceAdmins: ProtectionSetting{
+			Enabled: true,
+		},
+		RequiredPullRequestReviews: &RequiredPullRequestReviews{
+			DismissStaleReviews:          true,
+			RequireCodeOwnerReviews:      true,
+			RequiredApprovingReviewCount: 2,
+		},
+		Restrictions: &ProtectionRestrictions{
+			Users: []User{
+				{ID: 1, Login: "octocat", Type: "User"},
+			},
+			Teams: []ProtectionTeam{
+				{ID: 1, Name: "Justice League", Slug: "justice-league"},
+			},
+		},
+	}
+)
+
+func TestRepository_HighestPermission(t *testing.T) {
+	tests := []struct {
+		name               string
+		r                  Repository
+		expectedPermission Permission
+	}{
+		{
+			name:               "NoPermissions",
+			r:                  Repository{},
+			expectedPermission: PermissionNone,
+		},
+		{
+			name: "Admin",
+			r: Repository{
+				Permissions: &RepoPermissions{Admin: true, Maintain: true, Push: true, Triage: true, Pull: true},
+			},
+			expectedPermission: PermissionAdmin,
+		},
+		{
+			name: "Maintain",
+			r: Repository{
+				Permissions: &RepoPermissions{Maintain: true, Push: true, Triage: true, Pull: true},
+			},
+			expectedPermission: PermissionMaintain,
+		},
+		{
+			name:               "Write",
+			r:                  repository,
+			expectedPermission: PermissionWrite,
+		},
+		{
+			name: "Triage",
+			r: Repository{
+				Permissions: &RepoPermissions{Triage: true, Pull: true},
+			},
+			expectedPermission: PermissionTriage,
+		},
+		{
+			name: "Read",
+			r: Repository{
+				Permissions: &RepoPermissions{Pull: true},
+			},
+			expectedPermission: PermissionRead,
+		},
+		{
+			name: "None",
+			r: Repository{
+				Permissions: &RepoPermissions{},
+			},
+			expectedPermission: PermissionNone,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedPermission, tc.r.HighestPermission())
+		})
+	}
+}
+
+func TestReleaseParams_Validate(t *testing.T) {
+	tests := []struct {
+		name          string
+		p             ReleaseParams
+		expectedError string
+	}{
+		{
+			name:          "MissingTagName",
+			p:             ReleaseParams{Name: "v1.0.0"},
+			expectedError: `invalid release params: tag_name is required`,
+		},
+		{
+			name: "OK",
+			p:    ReleaseParams{Name: "v1.0.0", TagName: "v1.0.0"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.p.Validate()
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRepoService_Get(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *RepoService
+		ctx                context.Context
+		expectedRepository *Repository
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedError: `GET /repos/octocat/Hello-World: 401 Bad credentials`,
+		},
+		{
+			name: "ّInvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World", 200, http.Header{}, `[`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World", 200, header, repositoryBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                context.Background(),
+			expectedRepository: &repository,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			repository, resp, err := tc.s.Get(tc.ctx)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, repository)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRepository, repository)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_SetFeatureEnabled(t *testing.T) {
+	tests := []struct {
+		name               string
+		statusCode         int
+		respBody           string
+		set                func(*RepoService, context.Context, bool) (*Repository, *Response, error)
+		ctx                context.Context
+		enabled            bool
+		expectedBody       map[string]bool
+		expectedRepository *Repository
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			set:           (*RepoService).SetIssuesEnabled,
+			ctx:           nil,
+			enabled:       true,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name:          "InvalidStatusCode",
+			statusCode:    401,
+			respBody:      `{"message": "Bad credentials"}`,
+			set:           (*RepoService).SetIssuesEnabled,
+			ctx:           context.Background(),
+			enabled:       true,
+			expectedBody:  map[string]bool{"has_issues": true},
+			expectedError: `PATCH /repos/octocat/Hello-World: 401 Bad credentials`,
+		},
+		{
+			name:               "Issues",
+			statusCode:         200,
+			respBody:           repositoryBody,
+			set:                (*RepoService).SetIssuesEnabled,
+			ctx:                context.Background(),
+			enabled:            true,
+			expectedBody:       map[string]bool{"has_issues": true},
+			expectedRepository: &repository,
+		},
+		{
+			name:               "Wiki",
+			statusCode:         200,
+			respBody:           repositoryBody,
+			set:                (*RepoService).SetWikiEnabled,
+			ctx:                context.Background(),
+			enabled:            false,
+			expectedBody:       map[string]bool{"has_wiki": false},
+			expectedRepository: &repository,
+		},
+		{
+			name:               "Projects",
+			statusCode:         200,
+			respBody:           repositoryBody,
+			set:                (*RepoService).SetProjectsEnabled,
+			ctx:                context.Background(),
+			enabled:            false,
+			expectedBody:       map[string]bool{"has_projects": false},
+			expectedRepository: &repository,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var body map[string]bool
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "PATCH", r.Method)
+				assert.Equal(t, "/repos/octocat/Hello-World", r.URL.Path)
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				w.WriteHeader(tc.statusCode)
+				_, _ = io.WriteString(w, tc.respBody)
+			}))
+			defer ts.Close()
+
+			c := &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+			}
+			c.apiURL, _ = url.Parse(ts.URL)
+
+			s := &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			}
+
+			repository, resp, err := tc.set(s, tc.ctx, tc.enabled)
+
+			assert.Equal(t, tc.expectedBody, body)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, repository)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRepository, repository)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+			}
+		})
+	}
+}
+
+func TestRepoService_SetDefaultBranch(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *RepoService
+		ctx                context.Context
+		branch             string
+		expectedRepository *Repository
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			branch:        "main",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "BranchNotFound",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/branches/develop", 404, http.Header{}, `{
+					"message": "Branch not found"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "develop",
+			expectedError: `GET /repos/octocat/Hello-World/branches/develop: 404 Branch not found`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/branches/main", 200, header, branchBody},
+				{"PATCH", "/repos/octocat/Hello-World", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			expectedError: `PATCH /repos/octocat/Hello-World: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/branches/main", 200, header, branchBody},
+				{"PATCH", "/repos/octocat/Hello-World", 200, header, repositoryBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                context.Background(),
+			branch:             "main",
+			expectedRepository: &repository,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			repository, resp, err := tc.s.SetDefaultBranch(tc.ctx, tc.branch)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, repository)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRepository, repository)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_Counts(t *testing.T) {
+	tests := []struct {
+		name               string
+		handler            http.HandlerFunc
+		ctx                context.Context
+		expectedOpenIssues int
+		expectedOpenPulls  int
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			handler:       func(w http.ResponseWriter, r *http.Request) {},
+			ctx:           nil,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(401)
+				_, _ = io.WriteString(w, `{"message": "Bad credentials"}`)
+			},
+			ctx:           context.Background(),
+			expectedError: `GET /search/issues: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				for k, vals := range header {
+					w.Header()[k] = vals
+				}
+				if strings.Contains(r.URL.Query().Get("q"), "is:pr") {
+					_, _ = io.WriteString(w, `{"total_count": 3, "incomplete_results": false, "items": []}`)
+				} else {
+					_, _ = io.WriteString(w, `{"total_count": 12, "incomplete_results": false, "items": []}`)
+				}
+			},
+			ctx:                context.Background(),
+			expectedOpenIssues: 12,
+			expectedOpenPulls:  3,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := httptest.NewServer(tc.handler)
+			defer ts.Close()
+
+			c := &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+			}
+			c.apiURL, _ = url.Parse(ts.URL)
+
+			s := &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			}
+
+			openIssues, openPulls, resp, err := s.Counts(tc.ctx)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedOpenIssues, openIssues)
+				assert.Equal(t, tc.expectedOpenPulls, openPulls)
+				assert.NotNil(t, resp)
+				assert.Equal(t, expectedRate, resp.Rate)
+				assert.Equal(t, expectedRate, c.rates[rateGroupSearch])
+			}
+		})
+	}
+}
+
+func TestRepoService_Permission(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *RepoService
+		ctx                context.Context
+		username           string
+		expectedPermission Permission
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			username:      "octocat",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/collaborators/octocat/permission", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			username:      "octocat",
+			expectedError: `GET /repos/octocat/Hello-World/collaborators/octocat/permission: 401 Bad credentials`,
+		},
+		{
+			name: "ّInvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/collaborators/octocat/permission", 200, http.Header{}, `[`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			username:      "octocat",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/collaborators/octocat/permission", 200, header, permissionBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                context.Background(),
+			username:           "octocat",
+			expectedPermission: permission,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			permission, resp, err := tc.s.Permission(tc.ctx, tc.username)
+
+			if tc.expectedError != "" {
+				assert.Empty(t, permission)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedPermission, permission)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_DetailedPermission(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		username         string
+		expectedPerm     *CollaboratorPermission
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			username:      "octocat",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/collaborators/octocat/permission", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			username:      "octocat",
+			expectedError: `GET /repos/octocat/Hello-World/collaborators/octocat/permission: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/collaborators/octocat/permission", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			username:      "octocat",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/collaborators/octocat/permission", 200, header, customRolePermissionBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:          context.Background(),
+			username:     "octocat",
+			expectedPerm: &customRolePermission,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			perm, resp, err := tc.s.DetailedPermission(tc.ctx, tc.username)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, perm)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedPerm, perm)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_Languages(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name              string
+		mockResponses     []MockResponse
+		s                 *RepoService
+		ctx               context.Context
+		expectedLanguages map[string]int
+		expectedResponse  *Response
+		expectedError     string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: nil,
+
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/languages", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+
+			expectedError: `GET /repos/octocat/Hello-World/languages: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/languages", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/languages", 200, header, languagesBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+
+			expectedLanguages: languages,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			languages, resp, err := tc.s.Languages(tc.ctx)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, languages)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedLanguages, languages)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_PrimaryLanguage(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name              string
+		mockResponses     []MockResponse
+		s                 *RepoService
+		ctx               context.Context
+		expectedPrimary   string
+		expectedBreakdown map[string]float64
+		expectedResponse  *Response
+		expectedError     string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/languages", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedError: `GET /repos/octocat/Hello-World/languages: 401 Bad credentials`,
+		},
+		{
+			name: "Success_Empty",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/languages", 200, header, `{}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:               context.Background(),
+			expectedPrimary:   "",
+			expectedBreakdown: map[string]float64{},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+		{
+			name: "Success_Tie",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/languages", 200, header, `{"Shell": 500, "Go": 500}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:             context.Background(),
+			expectedPrimary: "Go",
+			expectedBreakdown: map[string]float64{
+				"Go":    50,
+				"Shell": 50,
+			},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/languages", 200, header, languagesBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:             context.Background(),
+			expectedPrimary: "Go",
+			expectedBreakdown: map[string]float64{
+				"Go":       75,
+				"Makefile": 15,
+				"Shell":    10,
+			},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			primary, breakdown, resp, err := tc.s.PrimaryLanguage(tc.ctx)
+
+			if tc.expectedError != "" {
+				assert.Empty(t, primary)
+				assert.Nil(t, breakdown)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedPrimary, primary)
+				assert.InDeltaMapValues(t, tc.expectedBreakdown, breakdown, 0.0001)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_Topics(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		expectedTopics   []string
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: nil,
+
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/topics", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+
+			expectedError: `GET /repos/octocat/Hello-World/topics: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/topics", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/topics", 200, header, topicsBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+
+			expectedTopics: []string{"octocat", "api"},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			topics, resp, err := tc.s.Topics(tc.ctx)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, topics)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedTopics, topics)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_ReplaceTopics(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		topics           []string
+		expectedNames    []string
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			topics:        []string{"octocat", "api"},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/topics", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			topics:        []string{"octocat", "api"},
+			expectedError: `PUT /repos/octocat/Hello-World/topics: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/topics", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			topics:        []string{"octocat", "api"},
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/topics", 200, header, topicsBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			topics:        []string{"octocat", "api"},
+			expectedNames: []string{"octocat", "api"},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			names, resp, err := tc.s.ReplaceTopics(tc.ctx, tc.topics)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, names)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedNames, names)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoContent_DecodedContent(t *testing.T) {
+	tests := []struct {
+		name            string
+		c               RepoContent
+		expectedContent []byte
+		expectedError   string
+	}{
+		{
+			name: "NoEncoding",
+			c: RepoContent{
+				Content: "v1.0.0\n",
+			},
+			expectedContent: []byte("v1.0.0\n"),
+		},
+		{
+			name:            "Base64",
+			c:               fileContent,
+			expectedContent: []byte("v1.0.0\n"),
+		},
+		{
+			name: "InvalidBase64",
+			c: RepoContent{
+				Encoding: "base64",
+				Content:  "!",
+			},
+			expectedError: `illegal base64 data at input byte 0`,
+		},
+		{
+			name: "UnsupportedEncoding",
+			c: RepoContent{
+				Encoding: "none",
+			},
+			expectedError: `unsupported content encoding: none`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			content, err := tc.c.DecodedContent()
+
+			if tc.expectedError != "" {
+				assert.Nil(t, content)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedContent, content)
+			}
+		})
+	}
+}
+
+func TestRepoService_CodeOwners(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	// A client that treats 404 as an empty list must still fall back to the next CODEOWNERS location
+	c404 := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+		emptyOn404: true,
+	}
+
+	codeOwnersBody := fmt.Sprintf(`{
+		"type": "file",
+		"encoding": "base64",
+		"name": "CODEOWNERS",
+		"path": "CODEOWNERS",
+		"content": "%s"
+	}`, base64.StdEncoding.EncodeToString([]byte(codeOwnersContent)))
+
+	notFound := `{
+		"message": "Not Found"
+	}`
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *RepoService
+		ctx                context.Context
+		ref                string
+		expectedCodeOwners *CodeOwners
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			ref:           "main",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/contents/.github/CODEOWNERS", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "main",
+			expectedError: `GET /repos/octocat/Hello-World/contents/.github/CODEOWNERS: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidContent",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/contents/.github/CODEOWNERS", 200, http.Header{}, `{
+					"type": "file",
+					"encoding": "base64",
+					"content": "!"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "main",
+			expectedError: `illegal base64 data at input byte 0`,
+		},
+		{
+			name: "InvalidPattern",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/contents/.github/CODEOWNERS", 200, http.Header{}, fmt.Sprintf(`{
+					"type": "file",
+					"encoding": "base64",
+					"content": "%s"
+				}`, base64.StdEncoding.EncodeToString([]byte("!*.js @octocat\n")))},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "main",
+			expectedError: `.github/CODEOWNERS:1: invalid CODEOWNERS pattern "!*.js": negation is not supported`,
+		},
+		{
+			name: "NotFound",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/contents/.github/CODEOWNERS", 404, http.Header{}, notFound},
+				{"GET", "/repos/octocat/Hello-World/contents/CODEOWNERS", 404, http.Header{}, notFound},
+				{"GET", "/repos/octocat/Hello-World/contents/docs/CODEOWNERS", 404, http.Header{}, notFound},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "main",
+			expectedError: `GET /repos/octocat/Hello-World/contents/docs/CODEOWNERS: 404 Not Found`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/contents/.github/CODEOWNERS", 404, http.Header{}, notFound},
+				{"GET", "/repos/octocat/Hello-World/contents/CODEOWNERS", 200, header, codeOwnersBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+			ref: "main",
+			expectedCodeOwners: &CodeOwners{
+				Path:  "CODEOWNERS",
+				Rules: codeOwners.Rules,
+			},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+		{
+			name: "SuccessTreat404AsEmpty",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/contents/.github/CODEOWNERS", 404, http.Header{}, notFound},
+				{"GET", "/repos/octocat/Hello-World/contents/CODEOWNERS", 200, header, codeOwnersBody},
+			},
+			s: &RepoService{
+				client: c404,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+			ref: "main",
+			expectedCodeOwners: &CodeOwners{
+				Path:  "CODEOWNERS",
+				Rules: codeOwners.Rules,
+			},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			owners, resp, err := tc.s.CodeOwners(tc.ctx, tc.ref)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, owners)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedCodeOwners, owners)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_Forks(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		pageSize         int
+		pageNo           int
+		expectedForks    []Repository
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/forks", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /repos/octocat/Hello-World/forks: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/forks", 200, http.Header{}, `[`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/forks", 200, header, orgReposBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedForks: []Repository{repository},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			forks, resp, err := tc.s.Forks(tc.ctx, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, forks)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedForks, forks)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_CreateFork(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		organization     string
+		expectedFork     *Repository
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			organization:  "octo-org",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/forks", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			organization:  "octo-org",
+			expectedError: `POST /repos/octocat/Hello-World/forks: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/forks", 202, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			organization:  "octo-org",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/forks", 202, header, repositoryBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:          context.Background(),
+			organization: "octo-org",
+			expectedFork: &repository,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			fork, resp, err := tc.s.CreateFork(tc.ctx, tc.organization)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, fork)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedFork, fork)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_Star(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PUT", "/user/starred/octocat/Hello-World", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedError: `PUT /user/starred/octocat/Hello-World: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PUT", "/user/starred/octocat/Hello-World", 204, header, ``},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.Star(tc.ctx)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_Unstar(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/user/starred/octocat/Hello-World", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedError: `DELETE /user/starred/octocat/Hello-World: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/user/starred/octocat/Hello-World", 204, header, ``},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.Unstar(tc.ctx)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_IsStarred(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		expectedStarred  bool
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/user/starred/octocat/Hello-World", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedError: `GET /user/starred/octocat/Hello-World: 401 Bad credentials`,
+		},
+		{
+			name: "NotStarred",
+			mockResponses: []MockResponse{
+				{"GET", "/user/starred/octocat/Hello-World", 404, header, `{
+					"message": "Not Found"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:             context.Background(),
+			expectedStarred: false,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+		{
+			name: "Starred",
+			mockResponses: []MockResponse{
+				{"GET", "/user/starred/octocat/Hello-World", 204, header, ``},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:             context.Background(),
+			expectedStarred: true,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			starred, resp, err := tc.s.IsStarred(tc.ctx)
+
+			if tc.expectedError != "" {
+				assert.False(t, starred)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedStarred, starred)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_Contents(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		path             string
+		ref              string
+		expectedFile     *RepoContent
+		expectedDir      []RepoContent
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			path:          "VERSION",
+			ref:           "main",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/contents/VERSION", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			path:          "VERSION",
+			ref:           "main",
+			expectedError: `GET /repos/octocat/Hello-World/contents/VERSION: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/contents/VERSION", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			path:          "VERSION",
+			ref:           "main",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "InvalidDirectoryResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/contents/", 200, http.Header{}, `[1]`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			path:          "",
+			ref:           "main",
+			expectedError: `json: cannot unmarshal number into .0 of type github.RepoContent`,
+		},
+		{
+			name: "Success_File",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/contents/VERSION", 200, header, fileContentBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:          context.Background(),
+			path:         "VERSION",
+			ref:          "main",
+			expectedFile: &fileContent,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+		{
+			name: "Success_Directory",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/contents/", 200, header, dirContentBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:         context.Background(),
+			path:        "",
+			ref:         "",
+			expectedDir: dirContents,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			file, dir, resp, err := tc.s.Contents(tc.ctx, tc.path, tc.ref)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, file)
+				assert.Nil(t, dir)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedFile, file)
+				assert.Equal(t, tc.expectedDir, dir)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_DownloadContents(t *testing.T) {
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		path           string
+		ref            string
+		statusCode     int
+		respBody       string
+		expectedQuery  string
+		expectedOutput string
+		expectedError  string
+	}{
+		{
+			name:          "NilContext",
+			ctx:           nil,
+			path:          "README.md",
+			ref:           "main",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name:          "InvalidStatusCode",
+			ctx:           context.Background(),
+			path:          "README.md",
+			ref:           "main",
+			statusCode:    401,
+			respBody:      `{"message": "Bad credentials"}`,
+			expectedQuery: "ref=main",
+			expectedError: `GET /repos/octocat/Hello-World/contents/README.md: 401 Bad credentials`,
+		},
+		{
+			name:           "Success",
+			ctx:            context.Background(),
+			path:           "README.md",
+			ref:            "",
+			statusCode:     200,
+			respBody:       "# Hello World\n",
+			expectedQuery:  "",
+			expectedOutput: "# Hello World\n",
+		},
+		{
+			name:           "SuccessWithRef",
+			ctx:            context.Background(),
+			path:           "README.md",
+			ref:            "main",
+			statusCode:     200,
+			respBody:       "# Hello World\n",
+			expectedQuery:  "ref=main",
+			expectedOutput: "# Hello World\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "GET", r.Method)
+				assert.Equal(t, "/repos/octocat/Hello-World/contents/README.md", r.URL.Path)
+				assert.Equal(t, tc.expectedQuery, r.URL.RawQuery)
+				assert.Equal(t, "application/vnd.github.v3.raw", r.Header.Get("Accept"))
+
+				for k, vals := range header {
+					w.Header()[k] = vals
+				}
+				w.WriteHeader(tc.statusCode)
+				_, _ = io.WriteString(w, tc.respBody)
+			}))
+			defer ts.Close()
+
+			c := &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+			}
+			c.apiURL, _ = url.Parse(ts.URL)
+
+			s := &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			}
+
+			buf := new(bytes.Buffer)
+			resp, err := s.DownloadContents(tc.ctx, tc.path, tc.ref, buf)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedOutput, buf.String())
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, expectedRate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_Contributors(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name                 string
+		mockResponses        []MockResponse
+		s                    *RepoService
+		ctx                  context.Context
+		pageSize             int
+		pageNo               int
+		params               ContributorsParams
+		expectedContributors []Contributor
+		expectedResponse     *Response
+		expectedError        string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			pageSize:      10,
+			pageNo:        1,
+			params:        ContributorsParams{Anon: true},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/contributors", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			params:        ContributorsParams{Anon: true},
+			expectedError: `GET /repos/octocat/Hello-World/contributors: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/contributors", 200, http.Header{}, `[`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			params:        ContributorsParams{Anon: true},
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/contributors", 200, header, contributorsBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                  context.Background(),
+			pageSize:             10,
+			pageNo:               1,
+			params:               ContributorsParams{Anon: true},
+			expectedContributors: []Contributor{contributor1, contributor2},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			contributors, resp, err := tc.s.Contributors(tc.ctx, tc.pageSize, tc.pageNo, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, contributors)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedContributors, contributors)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_PendingInvitations(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name                string
+		mockResponses       []MockResponse
+		s                   *RepoService
+		ctx                 context.Context
+		pageSize            int
+		pageNo              int
+		expectedInvitations []Invitation
+		expectedResponse    *Response
+		expectedError       string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/invitations", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /repos/octocat/Hello-World/invitations: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/invitations", 200, http.Header{}, `[`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/invitations", 200, header, invitationsBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                 context.Background(),
+			pageSize:            10,
+			pageNo:              1,
+			expectedInvitations: []Invitation{invitation},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			invitations, resp, err := tc.s.PendingInvitations(tc.ctx, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, invitations)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedInvitations, invitations)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_DeleteInvitation(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		invitationID     int
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			invitationID:  1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/invitations/1", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			invitationID:  1,
+			expectedError: `DELETE /repos/octocat/Hello-World/invitations/1: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/invitations/1", 204, header, ``},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:          context.Background(),
+			invitationID: 1,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.DeleteInvitation(tc.ctx, tc.invitationID)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_Commit(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		ref              string
+		expectedCommit   *Commit
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			ref:           "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			expectedError: `GET /repos/octocat/Hello-World/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e: 401 Bad credentials`,
+		},
+		{
+			name: "ّInvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e", 200, header, commitBody1},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:            context.Background(),
+			ref:            "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			expectedCommit: &commit1,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			commit, resp, err := tc.s.Commit(tc.ctx, tc.ref)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, commit)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedCommit, commit)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_CommitDiff(t *testing.T) {
+	diff := `diff --git a/README.md b/README.md
+index 2f1c8a6..c1a0f7e 100644
+--- a/README.md
++++ b/README.md
+@@ -1 +1 @@
+-Hello World
++Hello, World!
+`
+
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		ref           string
+		statusCode    int
+		respBody      string
+		expectedDiff  string
+		expectedError string
+	}{
+		{
+			name:          "NilContext",
+			ctx:           nil,
+			ref:           "main",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name:          "InvalidStatusCode",
+			ctx:           context.Background(),
+			ref:           "main",
+			statusCode:    401,
+			respBody:      `{"message": "Bad credentials"}`,
+			expectedError: `GET /repos/octocat/Hello-World/commits/main: 401 Bad credentials`,
+		},
+		{
+			name:         "Success",
+			ctx:          context.Background(),
+			ref:          "main",
+			statusCode:   200,
+			respBody:     diff,
+			expectedDiff: diff,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "GET", r.Method)
+				assert.Equal(t, "/repos/octocat/Hello-World/commits/main", r.URL.Path)
+				assert.Equal(t, "application/vnd.github.v3.diff", r.Header.Get("Accept"))
+
+				for k, vals := range header {
+					w.Header()[k] = vals
+				}
+				w.WriteHeader(tc.statusCode)
+				_, _ = io.WriteString(w, tc.respBody)
+			}))
+			defer ts.Close()
+
+			c := &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+			}
+			c.apiURL, _ = url.Parse(ts.URL)
+
+			s := &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			}
+
+			diff, resp, err := s.CommitDiff(tc.ctx, tc.ref)
+
+			if tc.expectedError != "" {
+				assert.Empty(t, diff)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedDiff, diff)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, expectedRate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_CommitSHA(t *testing.T) {
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		ref           string
+		statusCode    int
+		respBody      string
+		expectedSHA   string
+		expectedError string
+	}{
+		{
+			name:          "NilContext",
+			ctx:           nil,
+			ref:           "main",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name:          "InvalidStatusCode",
+			ctx:           context.Background(),
+			ref:           "main",
+			statusCode:    401,
+			respBody:      `{"message": "Bad credentials"}`,
+			expectedError: `GET /repos/octocat/Hello-World/commits/main: 401 Bad credentials`,
+		},
+		{
+			name:        "Success",
+			ctx:         context.Background(),
+			ref:         "main",
+			statusCode:  200,
+			respBody:    `6dcb09b5b57875f334f61aebed695e2e4193db5e`,
+			expectedSHA: "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "GET", r.Method)
+				assert.Equal(t, "/repos/octocat/Hello-World/commits/main", r.URL.Path)
+				assert.Equal(t, "application/vnd.github.v3.sha", r.Header.Get("Accept"))
+
+				for k, vals := range header {
+					w.Header()[k] = vals
+				}
+				w.WriteHeader(tc.statusCode)
+				_, _ = io.WriteString(w, tc.respBody)
+			}))
+			defer ts.Close()
+
+			c := &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+			}
+			c.apiURL, _ = url.Parse(ts.URL)
+
+			s := &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			}
+
+			sha, resp, err := s.CommitSHA(tc.ctx, tc.ref)
+
+			if tc.expectedError != "" {
+				assert.Empty(t, sha)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedSHA, sha)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, expectedRate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_CommitsByShas(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name            string
+		mockResponses   []MockResponse
+		s               *RepoService
+		ctx             context.Context
+		shas            []string
+		concurrency     int
+		expectedCommits map[string]*Commit
+		expectedError   string
+	}{
+		{
+			name:          "CancelledContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:             cancelledCtx,
+			shas:            []string{"6dcb09b5b57875f334f61aebed695e2e4193db5e", "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c"},
+			concurrency:     2,
+			expectedCommits: map[string]*Commit{},
+			expectedError:   `failed to retrieve 2 commit(s): 6dcb09b5b57875f334f61aebed695e2e4193db5e: context canceled; c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c: context canceled`,
+		},
+		{
+			name: "PartialFailure",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e", 200, http.Header{}, commitBody1},
+				{"GET", "/repos/octocat/Hello-World/commits/c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c", 404, http.Header{}, `{
+					"message": "Not Found"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:         context.Background(),
+			shas:        []string{"6dcb09b5b57875f334f61aebed695e2e4193db5e", "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c"},
+			concurrency: 2,
+			expectedCommits: map[string]*Commit{
+				"6dcb09b5b57875f334f61aebed695e2e4193db5e": &commit1,
+			},
+			expectedError: `failed to retrieve 1 commit(s): c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c: GET /repos/octocat/Hello-World/commits/c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c: 404 Not Found`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e", 200, http.Header{}, commitBody1},
+				{"GET", "/repos/octocat/Hello-World/commits/c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c", 200, http.Header{}, commitBody2},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:         context.Background(),
+			shas:        []string{"6dcb09b5b57875f334f61aebed695e2e4193db5e", "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c"},
+			concurrency: 0,
+			expectedCommits: map[string]*Commit{
+				"6dcb09b5b57875f334f61aebed695e2e4193db5e": &commit1,
+				"c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c": &commit2,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			commits, err := tc.s.CommitsByShas(tc.ctx, tc.shas, tc.concurrency)
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedCommits, commits)
+		})
+	}
+}
+
+func TestRepoService_Commits(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		pageSize         int
+		pageNo           int
+		expectedCommits  []Commit
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /repos/octocat/Hello-World/commits: 401 Bad credentials`,
+		},
+		{
+			name: "ّInvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits", 200, http.Header{}, `[`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits", 200, header, commitsBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:             context.Background(),
+			pageSize:        10,
+			pageNo:          1,
+			expectedCommits: []Commit{commit2, commit1},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			commits, resp, err := tc.s.Commits(tc.ctx, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, commits)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedCommits, commits)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_CommitsWithParams(t *testing.T) {
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		params          CommitsParams
+		expectedQuery   url.Values
+		expectedCommits []Commit
+		expectedError   string
+	}{
+		{
+			name:          "NilContext",
+			ctx:           nil,
+			params:        CommitsParams{},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name:   "NoParams",
+			ctx:    context.Background(),
+			params: CommitsParams{},
+			expectedQuery: url.Values{
+				"per_page": {"10"},
+				"page":     {"1"},
+			},
+			expectedCommits: []Commit{commit2, commit1},
+		},
+		{
+			name: "AllParams",
+			ctx:  context.Background(),
+			params: CommitsParams{
+				SHA:    "main",
+				Path:   "docs/README.md",
+				Author: "octocat",
+				Since:  parseGitHubTime("2020-10-01T00:00:00Z"),
+				Until:  parseGitHubTime("2020-10-31T00:00:00Z"),
+			},
+			expectedQuery: url.Values{
+				"per_page": {"10"},
+				"page":     {"1"},
+				"sha":      {"main"},
+				"path":     {"docs/README.md"},
+				"author":   {"octocat"},
+				"since":    {"2020-10-01T00:00:00Z"},
+				"until":    {"2020-10-31T00:00:00Z"},
+			},
+			expectedCommits: []Commit{commit2, commit1},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var query url.Values
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query = r.URL.Query()
+				for k, vals := range header {
+					w.Header()[k] = vals
+				}
+				_, _ = io.WriteString(w, commitsBody)
+			}))
+			defer ts.Close()
+
+			c := &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+			}
+			c.apiURL, _ = url.Parse(ts.URL)
+
+			s := &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			}
+
+			commits, resp, err := s.CommitsWithParams(tc.ctx, 10, 1, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, commits)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedQuery, query)
+				assert.Equal(t, tc.expectedCommits, commits)
+				assert.NotNil(t, resp)
+				assert.Equal(t, expectedPages, resp.Pages)
+				assert.Equal(t, expectedRate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_CommitsAll(t *testing.T) {
+	tests := []struct {
+		name            string
+		handler         http.HandlerFunc
+		ctx             context.Context
+		pageSize        int
+		yield           func(Commit) error
+		expectedCommits []Commit
+		expectedError   string
+	}{
+		{
+			name:          "NilContext",
+			handler:       func(w http.ResponseWriter, r *http.Request) {},
+			ctx:           nil,
+			pageSize:      2,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(401)
+				_, _ = io.WriteString(w, `{"message": "Bad credentials"}`)
+			},
+			ctx:           context.Background(),
+			pageSize:      2,
+			expectedError: `GET /repos/octocat/Hello-World/commits: 401 Bad credentials`,
+		},
+		{
+			name: "YieldError",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_, _ = io.WriteString(w, commitsBody)
+			},
+			ctx:      context.Background(),
+			pageSize: 2,
+			yield: func(Commit) error {
+				return errors.New("yield error")
+			},
+			expectedError: `yield error`,
+		},
+		{
+			name: "Success",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				for k, vals := range header {
+					if k != headerLink {
+						w.Header()[k] = vals
+					}
+				}
+
+				if r.URL.Query().Get("page") == "1" {
+					w.Header().Set(headerLink, `<https://api.github.com/repositories/100/commits?page=2>; rel="next", <https://api.github.com/repositories/100/commits?page=2>; rel="last"`)
+					_, _ = io.WriteString(w, commitsBody)
+				} else {
+					b, _ := json.Marshal([]Commit{commit1})
+					_, _ = w.Write(b)
+				}
+			},
+			ctx:             context.Background(),
+			pageSize:        2,
+			expectedCommits: []Commit{commit2, commit1, commit1},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := httptest.NewServer(tc.handler)
+			defer ts.Close()
+
+			c := &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+			}
+			c.apiURL, _ = url.Parse(ts.URL)
+
+			s := &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			}
+
+			var commits []Commit
+			yield := tc.yield
+			if yield == nil {
+				yield = func(c Commit) error {
+					commits = append(commits, c)
+					return nil
+				}
+			}
+
+			resp, err := s.CommitsAll(tc.ctx, tc.pageSize, yield)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedCommits, commits)
+				assert.NotNil(t, resp)
+				assert.Equal(t, 0, resp.Pages.Next)
+				assert.Equal(t, expectedRate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_CommitsSince(t *testing.T) {
+	tests := []struct {
+		name            string
+		handler         http.HandlerFunc
+		ctx             context.Context
+		branch          string
+		since           time.Time
+		expectedCommits []Commit
+		expectedError   string
+	}{
+		{
+			name:          "NilContext",
+			handler:       func(w http.ResponseWriter, r *http.Request) {},
+			ctx:           nil,
+			branch:        "main",
+			since:         parseGitHubTime("2020-10-01T00:00:00Z"),
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(401)
+				_, _ = io.WriteString(w, `{"message": "Bad credentials"}`)
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			since:         parseGitHubTime("2020-10-01T00:00:00Z"),
+			expectedError: `GET /repos/octocat/Hello-World/commits: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_, _ = io.WriteString(w, `[`)
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			since:         parseGitHubTime("2020-10-01T00:00:00Z"),
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				q := r.URL.Query()
+				if q.Get("sha") != "main" || q.Get("since") != "2020-10-01T00:00:00Z" {
+					w.WriteHeader(400)
+					_, _ = io.WriteString(w, `{"message": "Unexpected query"}`)
+					return
+				}
+
+				for k, vals := range header {
+					if k != headerLink {
+						w.Header()[k] = vals
+					}
+				}
+
+				if q.Get("page") == "1" {
+					w.Header().Set(headerLink, `<https://api.github.com/repositories/100/commits?page=2>; rel="next", <https://api.github.com/repositories/100/commits?page=2>; rel="last"`)
+					_, _ = io.WriteString(w, commitsBody)
+				} else {
+					_, _ = io.WriteString(w, `[]`)
+				}
+			},
+			ctx:             context.Background(),
+			branch:          "main",
+			since:           parseGitHubTime("2020-10-01T00:00:00Z"),
+			expectedCommits: []Commit{commit2, commit1},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := httptest.NewServer(tc.handler)
+			defer ts.Close()
+
+			c := &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+			}
+			c.apiURL, _ = url.Parse(ts.URL)
+
+			s := &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			}
+
+			commits, resp, err := s.CommitsSince(tc.ctx, tc.branch, tc.since)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, commits)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedCommits, commits)
+				assert.NotNil(t, resp)
+				assert.Equal(t, 0, resp.Pages.Next)
+				assert.Equal(t, expectedRate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_CommitsByEmail(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		email            string
+		pageSize         int
+		pageNo           int
+		expectedCommits  []Commit
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			email:         "octocat@github.com",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			email:         "octocat@github.com",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /repos/octocat/Hello-World/commits: 401 Bad credentials`,
+		},
+		{
+			name: "NoMatch",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits", 200, header, mixedEmailCommitsBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:             context.Background(),
+			email:           "octofox@github.com",
+			pageSize:        10,
+			pageNo:          1,
+			expectedCommits: []Commit{},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits", 200, header, mixedEmailCommitsBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:             context.Background(),
+			email:           "OctoCat@GitHub.com",
+			pageSize:        10,
+			pageNo:          1,
+			expectedCommits: []Commit{commit2},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			commits, resp, err := tc.s.CommitsByEmail(tc.ctx, tc.email, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, commits)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedCommits, commits)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_CompareCommits(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *RepoService
+		ctx                context.Context
+		base               string
+		head               string
+		expectedComparison *Comparison
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			base:          "v0.1.0",
+			head:          "main",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/compare/v0.1.0...main", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			base:          "v0.1.0",
+			head:          "main",
+			expectedError: `GET /repos/octocat/Hello-World/compare/v0.1.0...main: 401 Bad credentials`,
+		},
+		{
+			name: "NotFound",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/compare/v0.0.0...main", 404, http.Header{}, `{
+					"message": "Not Found"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			base:          "v0.0.0",
+			head:          "main",
+			expectedError: `GET /repos/octocat/Hello-World/compare/v0.0.0...main: 404 Not Found`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/compare/v0.1.0...main", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			base:          "v0.1.0",
+			head:          "main",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/compare/v0.1.0...main", 200, header, comparisonBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                context.Background(),
+			base:               "v0.1.0",
+			head:               "main",
+			expectedComparison: &comparison,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			comparison, resp, err := tc.s.CompareCommits(tc.ctx, tc.base, tc.head)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, comparison)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedComparison, comparison)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_DiffStat(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name              string
+		mockResponses     []MockResponse
+		s                 *RepoService
+		ctx               context.Context
+		base              string
+		head              string
+		expectedFiles     int
+		expectedAdditions int
+		expectedDeletions int
+		expectedResponse  *Response
+		expectedError     string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			base:          "v0.1.0",
+			head:          "main",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/compare/v0.1.0...main", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			base:          "v0.1.0",
+			head:          "main",
+			expectedError: `GET /repos/octocat/Hello-World/compare/v0.1.0...main: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/compare/v0.1.0...main", 200, header, comparisonBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:               context.Background(),
+			base:              "v0.1.0",
+			head:              "main",
+			expectedFiles:     2,
+			expectedAdditions: 104,
+			expectedDeletions: 22,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			files, additions, deletions, resp, err := tc.s.DiffStat(tc.ctx, tc.base, tc.head)
+
+			if tc.expectedError != "" {
+				assert.Zero(t, files)
+				assert.Zero(t, additions)
+				assert.Zero(t, deletions)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedFiles, files)
+				assert.Equal(t, tc.expectedAdditions, additions)
+				assert.Equal(t, tc.expectedDeletions, deletions)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_Statuses(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		ref              string
+		pageSize         int
+		pageNo           int
+		expectedStatuses []Status
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			ref:           "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e/statuses", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /repos/octocat/Hello-World/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e/statuses: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e/statuses", 200, http.Header{}, `[`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e/statuses", 200, header, statusesBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:              context.Background(),
+			ref:              "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			pageSize:         10,
+			pageNo:           1,
+			expectedStatuses: []Status{status},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			statuses, resp, err := tc.s.Statuses(tc.ctx, tc.ref, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, statuses)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedStatuses, statuses)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestStatus_Validate(t *testing.T) {
+	tests := []struct {
+		name          string
+		s             Status
+		expectedError string
+	}{
+		{
+			name:          "MissingState",
+			s:             Status{Context: "continuous-integration/jenkins"},
+			expectedError: `invalid status: state is required`,
+		},
+		{
+			name:          "UnknownState",
+			s:             Status{State: "passed"},
+			expectedError: `invalid status: unknown state "passed"`,
+		},
+		{
+			name: "OK",
+			s:    Status{State: "success"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.s.Validate()
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRepoService_CreateStatus(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		ref              string
+		status           Status
+		expectedCreated  *Status
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "InvalidParams",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			ref:           "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			status:        Status{},
+			expectedError: `invalid status: state is required`,
+		},
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: nil,
+			ref: "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			status: Status{
+				State:       "success",
+				TargetURL:   "https://ci.example.com/1000/output",
+				Description: "Build has completed successfully",
+				Context:     "continuous-integration/jenkins",
+			},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+			ref: "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			status: Status{
+				State:       "success",
+				TargetURL:   "https://ci.example.com/1000/output",
+				Description: "Build has completed successfully",
+				Context:     "continuous-integration/jenkins",
+			},
+			expectedError: `POST /repos/octocat/Hello-World/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e", 201, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+			ref: "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			status: Status{
+				State:       "success",
+				TargetURL:   "https://ci.example.com/1000/output",
+				Description: "Build has completed successfully",
+				Context:     "continuous-integration/jenkins",
+			},
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e", 201, header, statusBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+			ref: "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			status: Status{
+				State:       "success",
+				TargetURL:   "https://ci.example.com/1000/output",
+				Description: "Build has completed successfully",
+				Context:     "continuous-integration/jenkins",
+			},
+			expectedCreated: &status,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			created, resp, err := tc.s.CreateStatus(tc.ctx, tc.ref, tc.status)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, created)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedCreated, created)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_CombinedStatus(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		ref              string
+		expectedCombined *CombinedStatus
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			ref:           "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e/status", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			expectedError: `GET /repos/octocat/Hello-World/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e/status: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e/status", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e/status", 200, header, combinedStatusBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:              context.Background(),
+			ref:              "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			expectedCombined: &combinedStatus,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			combined, resp, err := tc.s.CombinedStatus(tc.ctx, tc.ref)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, combined)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedCombined, combined)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_CheckRuns(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name              string
+		mockResponses     []MockResponse
+		s                 *RepoService
+		ctx               context.Context
+		ref               string
+		pageSize          int
+		pageNo            int
+		expectedCheckRuns []CheckRun
+		expectedResponse  *Response
+		expectedError     string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			ref:           "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e/check-runs", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /repos/octocat/Hello-World/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e/check-runs: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e/check-runs", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e/check-runs", 200, header, checkRunsBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:               context.Background(),
+			ref:               "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			pageSize:          10,
+			pageNo:            1,
+			expectedCheckRuns: []CheckRun{checkRun},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			checkRuns, resp, err := tc.s.CheckRuns(tc.ctx, tc.ref, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, checkRuns)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedCheckRuns, checkRuns)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_Branch(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		branchName       string
+		expectedBranch   *Branch
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			branchName:    "main",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/branches/main", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branchName:    "main",
+			expectedError: `GET /repos/octocat/Hello-World/branches/main: 401 Bad credentials`,
+		},
+		{
+			name: "ّInvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/branches/main", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branchName:    "main",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/branches/main", 200, header, branchBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:            context.Background(),
+			branchName:     "main",
+			expectedBranch: &branch,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			branch, resp, err := tc.s.Branch(tc.ctx, tc.branchName)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, branch)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedBranch, branch)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_EscapedRefs(t *testing.T) {
+	tests := []struct {
+		name         string
+		call         func(*RepoService) error
+		expectedPath string
+	}{
+		{
+			name: "Commit",
+			call: func(s *RepoService) error {
+				_, _, err := s.Commit(context.Background(), "release/1.2")
+				return err
+			},
+			expectedPath: "/repos/octocat/Hello-World/commits/release%2F1.2",
+		},
+		{
+			name: "CompareCommits",
+			call: func(s *RepoService) error {
+				_, _, err := s.CompareCommits(context.Background(), "release/1.1", "release/1.2")
+				return err
+			},
+			expectedPath: "/repos/octocat/Hello-World/compare/release%2F1.1...release%2F1.2",
+		},
+		{
+			name: "CombinedStatus",
+			call: func(s *RepoService) error {
+				_, _, err := s.CombinedStatus(context.Background(), "release/1.2")
+				return err
+			},
+			expectedPath: "/repos/octocat/Hello-World/commits/release%2F1.2/status",
+		},
+		{
+			name: "Branch",
+			call: func(s *RepoService) error {
+				_, _, err := s.Branch(context.Background(), "release/1.2")
+				return err
+			},
+			expectedPath: "/repos/octocat/Hello-World/branches/release%2F1.2",
+		},
+		{
+			name: "BranchProtection",
+			call: func(s *RepoService) error {
+				_, err := s.BranchProtection(context.Background(), "release/1.2", true)
+				return err
+			},
+			expectedPath: "/repos/octocat/Hello-World/branches/release%2F1.2/protection/enforce_admins",
+		},
+		{
+			name: "DownloadTarArchive",
+			call: func(s *RepoService) error {
+				_, err := s.DownloadTarArchive(context.Background(), "release/1.2", ioutil.Discard)
+				return err
+			},
+			expectedPath: "/repos/octocat/Hello-World/tarball/release%2F1.2",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var path string
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				path = r.URL.EscapedPath()
+				_, _ = io.WriteString(w, `{}`)
+			}))
+			defer ts.Close()
+
+			c := &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+			}
+			c.apiURL, _ = url.Parse(ts.URL)
+
+			s := &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			}
+
+			assert.NoError(t, tc.call(s))
+			assert.Equal(t, tc.expectedPath, path)
+		})
+	}
+}
+
+func TestRepoService_BranchProtection(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		branch           string
+		enabled          bool
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			branch:        "main",
+			enabled:       true,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/branches/main/protection/enforce_admins", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			enabled:       true,
+			expectedError: `POST /repos/octocat/Hello-World/branches/main/protection/enforce_admins: 401 Bad credentials`,
+		},
+		{
+			name: "Success_Enable",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/branches/main/protection/enforce_admins", 200, header, ``},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:     context.Background(),
+			branch:  "main",
+			enabled: true,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+		{
+			name: "Success_Disable",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/branches/main/protection/enforce_admins", 204, header, ``},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:     context.Background(),
+			branch:  "main",
+			enabled: false,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.BranchProtection(tc.ctx, tc.branch, tc.enabled)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_GetBranchProtection(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *RepoService
+		ctx                context.Context
+		branch             string
+		expectedProtection *BranchProtection
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			branch:        "main",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/branches/main/protection", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			expectedError: `GET /repos/octocat/Hello-World/branches/main/protection: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/branches/main/protection", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/branches/main/protection", 200, header, branchProtectionBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                context.Background(),
+			branch:             "main",
+			expectedProtection: &branchProtection,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			protection, resp, err := tc.s.GetBranchProtection(tc.ctx, tc.branch)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, protection)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedProtection, protection)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_GetBranchProtection_Unprotected(t *testing.T) {
+	ts := newHTTPTestServer(MockResponse{"GET", "/repos/octocat/Hello-World/branches/develop/protection", 404, header, `{
+		"message": "Branch not protected",
+		"documentation_url": "https://docs.github.com/rest/reference/repos#get-branch-protection"
+	}`})
+	defer ts.Close()
+
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+	}
+	c.apiURL, _ = url.Parse(ts.URL)
+
+	s := &RepoService{
+		client: c,
+		owner:  "octocat",
+		repo:   "Hello-World",
+	}
+
+	protection, resp, err := s.GetBranchProtection(context.Background(), "develop")
+
+	assert.Nil(t, protection)
+	assert.Nil(t, resp)
+	assert.EqualError(t, err, `GET /repos/octocat/Hello-World/branches/develop/protection: 404 Branch not protected`)
+
+	var notFoundErr *NotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+}
+
+func TestRepoService_SetBranchProtection(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	rules := BranchProtectionRules{
+		RequiredStatusChecks: &RequiredStatusChecks{
+			Strict:   true,
+			Contexts: []string{"continuous-integration/travis-ci"},
+		},
+		EnforceAdmins: true,
+		RequiredPullRequestReviews: &RequiredPullRequestReviews{
+			DismissStaleReviews:          true,
+			RequireCodeOwnerReviews:      true,
+			RequiredApprovingReviewCount: 2,
+		},
+		Restrictions: &BranchRestrictions{
+			Users: []string{"octocat"},
+			Teams: []string{"justice-league"},
+		},
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *RepoService
+		ctx                context.Context
+		branch             string
+		rules              BranchProtectionRules
+		expectedProtection *BranchProtection
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			branch:        "main",
+			rules:         rules,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/branches/main/protection", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			rules:         rules,
+			expectedError: `PUT /repos/octocat/Hello-World/branches/main/protection: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/branches/main/protection", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			rules:         rules,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/branches/main/protection", 200, header, branchProtectionBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                context.Background(),
+			branch:             "main",
+			rules:              rules,
+			expectedProtection: &branchProtection,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			protection, resp, err := tc.s.SetBranchProtection(tc.ctx, tc.branch, tc.rules)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, protection)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedProtection, protection)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_DeleteBranchProtection(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		branch           string
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			branch:        "main",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/branches/main/protection", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			expectedError: `DELETE /repos/octocat/Hello-World/branches/main/protection: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/branches/main/protection", 204, header, ``},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:    context.Background(),
+			branch: "main",
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.DeleteBranchProtection(tc.ctx, tc.branch)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_Activity(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *RepoService
+		ctx                context.Context
+		pageSize           int
+		pageNo             int
+		params             ActivityParams
+		expectedActivities []RepoActivity
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:      nil,
+			pageSize: 10,
+			pageNo:   1,
+			params: ActivityParams{
+				Ref:          "refs/heads/main",
+				ActivityType: "force_push",
+				TimePeriod:   "week",
+				Actor:        "octocat",
+			},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/activity", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:      context.Background(),
+			pageSize: 10,
+			pageNo:   1,
+			params: ActivityParams{
+				Ref:          "refs/heads/main",
+				ActivityType: "force_push",
+				TimePeriod:   "week",
+				Actor:        "octocat",
+			},
+			expectedError: `GET /repos/octocat/Hello-World/activity: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/activity", 200, http.Header{}, `[`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:      context.Background(),
+			pageSize: 10,
+			pageNo:   1,
+			params: ActivityParams{
+				Ref:          "refs/heads/main",
+				ActivityType: "force_push",
+				TimePeriod:   "week",
+				Actor:        "octocat",
+			},
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/activity", 200, header, activityBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:      context.Background(),
+			pageSize: 10,
+			pageNo:   1,
+			params: ActivityParams{
+				Ref:          "refs/heads/main",
+				ActivityType: "force_push",
+				TimePeriod:   "week",
+				Actor:        "octocat",
+			},
+			expectedActivities: []RepoActivity{activity},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			activities, resp, err := tc.s.Activity(tc.ctx, tc.pageSize, tc.pageNo, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, activities)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedActivities, activities)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_WorkflowRun(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		runID            int
+		expectedRun      *WorkflowRun
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			runID:         30433642,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runs/30433642", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			runID:         30433642,
+			expectedError: `GET /repos/octocat/Hello-World/actions/runs/30433642: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runs/30433642", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			runID:         30433642,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runs/30433642", 200, header, workflowRunBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:         context.Background(),
+			runID:       30433642,
+			expectedRun: &workflowRun,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			run, resp, err := tc.s.WorkflowRun(tc.ctx, tc.runID)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, run)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRun, run)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_WaitForWorkflowRun(t *testing.T) {
+	inProgressBody := strings.Replace(strings.Replace(workflowRunBody, `"completed"`, `"in_progress"`, 1), `"success"`, `null`, 1)
+
+	shortCtx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	tests := []struct {
+		name          string
+		bodies        []string
+		ctx           context.Context
+		interval      time.Duration
+		expectedCalls int
+		expectedRun   *WorkflowRun
+		expectedError string
+	}{
+		{
+			name:          "ZeroInterval",
+			bodies:        []string{},
+			ctx:           context.Background(),
+			interval:      0,
+			expectedCalls: 0,
+			expectedError: `invalid interval 0s: interval must be positive`,
+		},
+		{
+			name:          "NegativeInterval",
+			bodies:        []string{},
+			ctx:           context.Background(),
+			interval:      -time.Second,
+			expectedCalls: 0,
+			expectedError: `invalid interval -1s: interval must be positive`,
+		},
+		{
+			name:          "NilContext",
+			bodies:        []string{},
+			ctx:           nil,
+			interval:      time.Millisecond,
+			expectedCalls: 0,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name:          "InvalidResponse",
+			bodies:        []string{`{`},
+			ctx:           context.Background(),
+			interval:      time.Millisecond,
+			expectedCalls: 1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name:          "ContextTimeout",
+			bodies:        []string{inProgressBody, inProgressBody, inProgressBody},
+			ctx:           shortCtx,
+			interval:      time.Hour,
+			expectedCalls: 1,
+			expectedError: `context deadline exceeded`,
+		},
+		{
+			name:          "Success",
+			bodies:        []string{inProgressBody, inProgressBody, workflowRunBody},
+			ctx:           context.Background(),
+			interval:      time.Millisecond,
+			expectedCalls: 3,
+			expectedRun:   &workflowRun,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls int
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body := tc.bodies[calls]
+				calls++
+
+				for k, vals := range header {
+					w.Header()[k] = vals
+				}
+				_, _ = io.WriteString(w, body)
+			}))
+			defer ts.Close()
+
+			c := &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+			}
+			c.apiURL, _ = url.Parse(ts.URL)
+
+			s := &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			}
+
+			run, resp, err := s.WaitForWorkflowRun(tc.ctx, 30433642, tc.interval)
+
+			assert.Equal(t, tc.expectedCalls, calls)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, run)
+				assert.Nil(t, resp)
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRun, run)
+				assert.NotNil(t, resp)
+				assert.Equal(t, expectedRate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_Webhooks(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		pageSize         int
+		pageNo           int
+		expectedHooks    []Webhook
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/hooks", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /repos/octocat/Hello-World/hooks: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/hooks", 200, http.Header{}, `[`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/hooks", 200, header, webhooksBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedHooks: []Webhook{webhook},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			hooks, resp, err := tc.s.Webhooks(tc.ctx, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, hooks)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedHooks, hooks)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_CreateWebhook(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		hook             Webhook
+		expectedCreated  *Webhook
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: nil,
+			hook: Webhook{
+				Name:   "web",
+				Active: true,
+				Events: []string{"push", "pull_request"},
+				Config: map[string]string{
+					"url":          "https://example.com/webhook",
+					"content_type": "json",
+				},
+			},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/hooks", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+			hook: Webhook{
+				Name:   "web",
+				Active: true,
+				Events: []string{"push", "pull_request"},
+				Config: map[string]string{
+					"url":          "https://example.com/webhook",
+					"content_type": "json",
+				},
+			},
+			expectedError: `POST /repos/octocat/Hello-World/hooks: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/hooks", 201, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+			hook: Webhook{
+				Name:   "web",
+				Active: true,
+				Events: []string{"push", "pull_request"},
+				Config: map[string]string{
+					"url":          "https://example.com/webhook",
+					"content_type": "json",
+				},
+			},
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/hooks", 201, header, webhookBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+			hook: Webhook{
+				Name:   "web",
+				Active: true,
+				Events: []string{"push", "pull_request"},
+				Config: map[string]string{
+					"url":          "https://example.com/webhook",
+					"content_type": "json",
+				},
+			},
+			expectedCreated: &webhook,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			created, resp, err := tc.s.CreateWebhook(tc.ctx, tc.hook)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, created)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedCreated, created)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_DeleteWebhook(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		hookID           int
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			hookID:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/hooks/1", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			hookID:        1,
+			expectedError: `DELETE /repos/octocat/Hello-World/hooks/1: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/hooks/1", 204, header, ``},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:    context.Background(),
+			hookID: 1,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.DeleteWebhook(tc.ctx, tc.hookID)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_HookDeliveries(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *RepoService
+		ctx                context.Context
+		hookID             int
+		pageSize           int
+		pageNo             int
+		expectedDeliveries []HookDelivery
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			hookID:        1,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/hooks/1/deliveries", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			hookID:        1,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /repos/octocat/Hello-World/hooks/1/deliveries: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/hooks/1/deliveries", 200, http.Header{}, `[`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			hookID:        1,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/hooks/1/deliveries", 200, header, hookDeliveriesBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                context.Background(),
+			hookID:             1,
+			pageSize:           10,
+			pageNo:             1,
+			expectedDeliveries: []HookDelivery{hookDelivery, hookRedelivery},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			deliveries, resp, err := tc.s.HookDeliveries(tc.ctx, tc.hookID, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, deliveries)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedDeliveries, deliveries)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_RedeliverHook(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		hookID           int
+		deliveryID       int
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			hookID:        1,
+			deliveryID:    12345678,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/hooks/1/deliveries/12345678/attempts", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			hookID:        1,
+			deliveryID:    12345678,
+			expectedError: `POST /repos/octocat/Hello-World/hooks/1/deliveries/12345678/attempts: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/hooks/1/deliveries/12345678/attempts", 202, header, `{}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:        context.Background(),
+			hookID:     1,
+			deliveryID: 12345678,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.RedeliverHook(tc.ctx, tc.hookID, tc.deliveryID)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_Tags(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		pageSize         int
+		pageNo           int
+		expectedTags     []Tag
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/tags", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /repos/octocat/Hello-World/tags: 401 Bad credentials`,
+		},
+		{
+			name: "ّInvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/tags", 200, http.Header{}, `[`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/tags", 200, header, tagsBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:          context.Background(),
+			pageSize:     10,
+			pageNo:       1,
+			expectedTags: []Tag{tag},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			tags, resp, err := tc.s.Tags(tc.ctx, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, tags)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedTags, tags)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_GitTag(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		sha              string
+		expectedTag      *GitTag
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			sha:           "940bd336248efae0f9ee5bc7b2d5c985887b16ac",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/tags/940bd336248efae0f9ee5bc7b2d5c985887b16ac", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			sha:           "940bd336248efae0f9ee5bc7b2d5c985887b16ac",
+			expectedError: `GET /repos/octocat/Hello-World/git/tags/940bd336248efae0f9ee5bc7b2d5c985887b16ac: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/tags/940bd336248efae0f9ee5bc7b2d5c985887b16ac", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			sha:           "940bd336248efae0f9ee5bc7b2d5c985887b16ac",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/tags/940bd336248efae0f9ee5bc7b2d5c985887b16ac", 200, header, gitTagBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:         context.Background(),
+			sha:         "940bd336248efae0f9ee5bc7b2d5c985887b16ac",
+			expectedTag: &gitTag,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			tag, resp, err := tc.s.GitTag(tc.ctx, tc.sha)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, tag)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedTag, tag)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_TagRef(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		tag              string
+		expectedRef      *Reference
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			tag:           "v0.1.0",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/ref/tags/v0.1.0", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			tag:           "v0.1.0",
+			expectedError: `GET /repos/octocat/Hello-World/git/ref/tags/v0.1.0: 401 Bad credentials`,
+		},
+		{
+			name: "NotFound",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/ref/tags/v0.1.0", 404, http.Header{}, `{
+					"message": "Not Found"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			tag:           "v0.1.0",
+			expectedError: `GET /repos/octocat/Hello-World/git/ref/tags/v0.1.0: 404 Not Found`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/ref/tags/v0.1.0", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			tag:           "v0.1.0",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/ref/tags/v0.1.0", 200, header, `{
+					"ref": "refs/tags/v0.1.0",
+					"url": "https://api.github.com/repos/octocat/Hello-World/git/refs/tags/v0.1.0",
+					"object": {
+						"type": "commit",
+						"sha": "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+						"url": "https://api.github.com/repos/octocat/Hello-World/git/commits/c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c"
+					}
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+			tag: "v0.1.0",
+			expectedRef: &Reference{
+				Ref: "refs/tags/v0.1.0",
+				URL: "https://api.github.com/repos/octocat/Hello-World/git/refs/tags/v0.1.0",
+				Object: ReferenceObject{
+					Type: "commit",
+					SHA:  "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+					URL:  "https://api.github.com/repos/octocat/Hello-World/git/commits/c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+				},
+			},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			ref, resp, err := tc.s.TagRef(tc.ctx, tc.tag)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, ref)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRef, ref)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_CreateTagObject(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		respBody       string
+		ctx            context.Context
+		tag            string
+		message        string
+		sha            string
+		taggerName     string
+		taggerEmail    string
+		expectedGitTag *GitTag
+		expectedError  string
+	}{
+		{
+			name:          "NilContext",
+			ctx:           nil,
+			tag:           "v1.0.0",
+			message:       "Release v1.0.0",
+			sha:           "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+			taggerName:    "Monalisa Octocat",
+			taggerEmail:   "octocat@github.com",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name:          "InvalidStatusCode",
+			statusCode:    401,
+			respBody:      `{"message": "Bad credentials"}`,
+			ctx:           context.Background(),
+			tag:           "v1.0.0",
+			message:       "Release v1.0.0",
+			sha:           "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+			taggerName:    "Monalisa Octocat",
+			taggerEmail:   "octocat@github.com",
+			expectedError: `POST /repos/octocat/Hello-World/git/tags: 401 Bad credentials`,
+		},
+		{
+			name:           "Success",
+			statusCode:     201,
+			respBody:       gitTagBody,
+			ctx:            context.Background(),
+			tag:            "v1.0.0",
+			message:        "Release v1.0.0",
+			sha:            "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+			taggerName:     "Monalisa Octocat",
+			taggerEmail:    "octocat@github.com",
+			expectedGitTag: &gitTag,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var body struct {
+					Tag     string    `json:"tag"`
+					Message string    `json:"message"`
+					Object  string    `json:"object"`
+					Type    string    `json:"type"`
+					Tagger  Signature `json:"tagger"`
+				}
+
+				assert.Equal(t, "POST", r.Method)
+				assert.Equal(t, "/repos/octocat/Hello-World/git/tags", r.URL.Path)
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, tc.tag, body.Tag)
+				assert.Equal(t, tc.message, body.Message)
+				assert.Equal(t, tc.sha, body.Object)
+				assert.Equal(t, "commit", body.Type)
+				assert.Equal(t, tc.taggerName, body.Tagger.Name)
+				assert.Equal(t, tc.taggerEmail, body.Tagger.Email)
+				assert.False(t, body.Tagger.Time.IsZero())
+
+				for k, vals := range header {
+					w.Header()[k] = vals
+				}
+				w.WriteHeader(tc.statusCode)
+				_, _ = io.WriteString(w, tc.respBody)
+			}))
+			defer ts.Close()
+
+			c := &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+			}
+			c.apiURL, _ = url.Parse(ts.URL)
+
+			s := &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			}
+
+			tag, resp, err := s.CreateTagObject(tc.ctx, tc.tag, tc.message, tc.sha, tc.taggerName, tc.taggerEmail)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, tag)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedGitTag, tag)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, expectedRate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_Milestones(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *RepoService
+		ctx                context.Context
+		pageSize           int
+		pageNo             int
+		params             MilestonesParams
+		expectedMilestones []Milestone
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:      nil,
+			pageSize: 10,
+			pageNo:   1,
+			params: MilestonesParams{
+				State: "open",
+			},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/milestones", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:      context.Background(),
+			pageSize: 10,
+			pageNo:   1,
+			params: MilestonesParams{
+				State: "open",
+			},
+			expectedError: `GET /repos/octocat/Hello-World/milestones: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/milestones", 200, http.Header{}, `[`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:      context.Background(),
+			pageSize: 10,
+			pageNo:   1,
+			params: MilestonesParams{
+				State: "open",
+			},
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/milestones", 200, header, milestonesBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:      context.Background(),
+			pageSize: 10,
+			pageNo:   1,
+			params: MilestonesParams{
+				State: "open",
+			},
+			expectedMilestones: []Milestone{milestone},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			milestones, resp, err := tc.s.Milestones(tc.ctx, tc.pageSize, tc.pageNo, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, milestones)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedMilestones, milestones)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_CreateMilestone(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name              string
+		mockResponses     []MockResponse
+		s                 *RepoService
+		ctx               context.Context
+		title             string
+		description       string
+		state             string
+		dueOn             *time.Time
+		expectedMilestone *Milestone
+		expectedResponse  *Response
+		expectedError     string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			title:         "v1.0",
+			description:   "Tracking milestone for version 1.0",
+			state:         "open",
+			dueOn:         parseGitHubTimePtr("2020-12-31T00:00:00Z"),
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/milestones", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			title:         "v1.0",
+			description:   "Tracking milestone for version 1.0",
+			state:         "open",
+			dueOn:         parseGitHubTimePtr("2020-12-31T00:00:00Z"),
+			expectedError: `POST /repos/octocat/Hello-World/milestones: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/milestones", 201, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			title:         "v1.0",
+			description:   "Tracking milestone for version 1.0",
+			state:         "open",
+			dueOn:         parseGitHubTimePtr("2020-12-31T00:00:00Z"),
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/milestones", 201, header, milestoneBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:               context.Background(),
+			title:             "v1.0",
+			description:       "Tracking milestone for version 1.0",
+			state:             "open",
+			dueOn:             parseGitHubTimePtr("2020-12-31T00:00:00Z"),
+			expectedMilestone: &milestone,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			milestone, resp, err := tc.s.CreateMilestone(tc.ctx, tc.title, tc.description, tc.state, tc.dueOn)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, milestone)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedMilestone, milestone)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_Issues(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	since, _ := time.Parse(time.RFC3339, "2020-10-20T22:30:00-04:00")
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		pageSize         int
+		pageNo           int
+		params           IssuesParams
+		expectedIssues   []Issue
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:      nil,
+			pageSize: 10,
+			pageNo:   1,
+			params: IssuesParams{
+				State: "closed",
+				Since: since,
+			},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/issues", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:      context.Background(),
+			pageSize: 10,
+			pageNo:   1,
+			params: IssuesParams{
+				State: "closed",
+				Since: since,
+			},
+			expectedError: `GET /repos/octocat/Hello-World/issues: 401 Bad credentials`,
+		},
+		{
+			name: "ّInvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/issues", 200, http.Header{}, `[`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:      context.Background(),
+			pageSize: 10,
+			pageNo:   1,
+			params: IssuesParams{
+				State: "closed",
+				Since: since,
+			},
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/issues", 200, header, issuesBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:      context.Background(),
+			pageSize: 10,
+			pageNo:   1,
+			params: IssuesParams{
+				State: "closed",
+				Since: since,
+			},
+			expectedIssues: []Issue{issue2, issue1},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			issues, resp, err := tc.s.Issues(tc.ctx, tc.pageSize, tc.pageNo, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, issues)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedIssues, issues)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_Issues_ModifiedSince(t *testing.T) {
+	modifiedSince := parseGitHubTime("2020-10-20T20:00:00Z")
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for k, vals := range header {
+			w.Header()[k] = vals
+		}
+
+		if r.Header.Get("If-Modified-Since") == "Tue, 20 Oct 2020 20:00:00 GMT" {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		_, _ = io.WriteString(w, issuesBody)
+	}))
+	defer ts.Close()
+
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+	}
+	c.apiURL, _ = url.Parse(ts.URL)
+
+	s := &RepoService{
+		client: c,
+		owner:  "octocat",
+		repo:   "Hello-World",
+	}
+
+	issues, resp, err := s.Issues(context.Background(), 10, 1, IssuesParams{
+		ModifiedSince: modifiedSince,
+	})
+
+	assert.Nil(t, issues)
+	assert.Nil(t, resp)
+	assert.True(t, errors.Is(err, ErrNotModified))
+}
+
+func TestRepoService_Issues_ModifiedSinceWithCache(t *testing.T) {
+	modifiedSince := parseGitHubTime("2020-10-20T20:00:00Z")
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The cached ETag is not sent, so the cache cannot mask a 304
+		assert.Empty(t, r.Header.Get("If-None-Match"))
+
+		for k, vals := range header {
+			w.Header()[k] = vals
+		}
+
+		if r.Header.Get("If-Modified-Since") == "Tue, 20 Oct 2020 20:00:00 GMT" {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		_, _ = io.WriteString(w, issuesBody)
+	}))
+	defer ts.Close()
+
+	cache := mapCache{
+		ts.URL + "/repos/octocat/Hello-World/issues?page=1&per_page=10": {
+			ETag: `"644b5b0155e6404a9cc4bd9d8b1ae730"`,
+			Body: []byte(issuesBody),
+		},
+	}
+
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		cache:      cache,
+	}
+	c.apiURL, _ = url.Parse(ts.URL)
+
+	s := &RepoService{
+		client: c,
+		owner:  "octocat",
+		repo:   "Hello-World",
+	}
+
+	issues, resp, err := s.Issues(context.Background(), 10, 1, IssuesParams{
+		ModifiedSince: modifiedSince,
+	})
+
+	assert.Nil(t, issues)
+	assert.Nil(t, resp)
+	assert.True(t, errors.Is(err, ErrNotModified))
+}
+
+func TestRepoService_Issues_Query(t *testing.T) {
+	tests := []struct {
+		name          string
+		params        IssuesParams
+		expectedQuery url.Values
+	}{
+		{
+			name:   "NoParams",
+			params: IssuesParams{},
+			expectedQuery: url.Values{
+				"per_page": {"10"},
+				"page":     {"1"},
+			},
+		},
+		{
+			name: "AllParams",
+			params: IssuesParams{
+				State:     "open",
+				Since:     parseGitHubTime("2020-10-01T00:00:00Z"),
+				Labels:    []string{"bug", "help wanted"},
+				Assignee:  "octocat",
+				Creator:   "octodog",
+				Mentioned: "octofox",
+				Sort:      "updated",
+				Direction: "asc",
+			},
+			expectedQuery: url.Values{
+				"per_page":  {"10"},
+				"page":      {"1"},
+				"state":     {"open"},
+				"since":     {"2020-10-01T00:00:00Z"},
+				"labels":    {"bug,help wanted"},
+				"assignee":  {"octocat"},
+				"creator":   {"octodog"},
+				"mentioned": {"octofox"},
+				"sort":      {"updated"},
+				"direction": {"asc"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var query url.Values
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query = r.URL.Query()
+				_, _ = io.WriteString(w, `[]`)
+			}))
+			defer ts.Close()
+
+			c := &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+			}
+			c.apiURL, _ = url.Parse(ts.URL)
+
+			s := &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			}
+
+			issues, _, err := s.Issues(context.Background(), 10, 1, tc.params)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []Issue{}, issues)
+			assert.Equal(t, tc.expectedQuery, query)
+		})
+	}
+}
+
+func TestRepoService_ExportIssues(t *testing.T) {
+	line1, _ := json.Marshal(issue1)
+	line2, _ := json.Marshal(issue2)
+
+	tests := []struct {
+		name            string
+		handler         http.HandlerFunc
+		ctx             context.Context
+		params          IssuesParams
+		w               io.Writer
+		expectedOutput  string
+		expectedFlushed []string
+		expectedError   string
+	}{
+		{
+			name:          "NilContext",
+			handler:       func(w http.ResponseWriter, r *http.Request) {},
+			ctx:           nil,
+			params:        IssuesParams{},
+			w:             new(bytes.Buffer),
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(401)
+				_, _ = io.WriteString(w, `{"message": "Bad credentials"}`)
+			},
+			ctx:           context.Background(),
+			params:        IssuesParams{},
+			w:             new(bytes.Buffer),
+			expectedError: `GET /repos/octocat/Hello-World/issues: 401 Bad credentials`,
+		},
+		{
+			name: "WriterError",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_, _ = io.WriteString(w, issuesBody)
+			},
+			ctx:           context.Background(),
+			params:        IssuesParams{},
+			w:             &errorWriter{errors.New("io error")},
+			expectedError: `io error`,
+		},
+		{
+			name: "Success",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				for k, vals := range header {
+					if k != headerLink {
+						w.Header()[k] = vals
+					}
+				}
+				if r.URL.Query().Get("page") == "1" {
+					w.Header().Set(headerLink, `<https://api.github.com/repositories/100/issues?page=2>; rel="next", <https://api.github.com/repositories/100/issues?page=2>; rel="last"`)
+					_, _ = io.WriteString(w, `[`+string(line1)+`]`)
+				} else {
+					_, _ = io.WriteString(w, `[`+string(line2)+`]`)
+				}
+			},
+			ctx: context.Background(),
+			params: IssuesParams{
+				State: "all",
+			},
+			w:              new(bytes.Buffer),
+			expectedOutput: string(line1) + "\n" + string(line2) + "\n",
+		},
+		{
+			name: "FlushError",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_, _ = io.WriteString(w, issuesBody)
+			},
+			ctx:           context.Background(),
+			params:        IssuesParams{},
+			w:             &flushWriter{err: errors.New("flush error")},
+			expectedError: `flush error`,
+		},
+		{
+			name: "SuccessFlush",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				for k, vals := range header {
+					if k != headerLink {
+						w.Header()[k] = vals
+					}
+				}
+				if r.URL.Query().Get("page") == "1" {
+					w.Header().Set(headerLink, `<https://api.github.com/repositories/100/issues?page=2>; rel="next", <https://api.github.com/repositories/100/issues?page=2>; rel="last"`)
+					_, _ = io.WriteString(w, `[`+string(line1)+`]`)
+				} else {
+					_, _ = io.WriteString(w, `[`+string(line2)+`]`)
+				}
+			},
+			ctx: context.Background(),
+			params: IssuesParams{
+				State: "all",
+			},
+			w:              new(flushWriter),
+			expectedOutput: string(line1) + "\n" + string(line2) + "\n",
+			expectedFlushed: []string{
+				string(line1) + "\n",
+				string(line1) + "\n" + string(line2) + "\n",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := httptest.NewServer(tc.handler)
+			defer ts.Close()
+
+			c := &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+			}
+			c.apiURL, _ = url.Parse(ts.URL)
+
+			s := &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			}
+
+			resp, err := s.ExportIssues(tc.ctx, tc.params, tc.w)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedOutput, tc.w.(fmt.Stringer).String())
+				if fw, ok := tc.w.(*flushWriter); ok {
+					assert.Equal(t, tc.expectedFlushed, fw.flushed)
+				}
+				assert.NotNil(t, resp)
+				assert.Equal(t, 0, resp.Pages.Next)
+				assert.Equal(t, expectedRate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestIssueParams_Validate(t *testing.T) {
+	tests := []struct {
+		name          string
+		p             IssueParams
+		expectedError string
+	}{
+		{"Empty", IssueParams{}, ""},
+		{"Completed", IssueParams{State: "closed", StateReason: "completed"}, ""},
+		{"NotPlanned", IssueParams{State: "closed", StateReason: "not_planned"}, ""},
+		{"Reopened", IssueParams{State: "open", StateReason: "reopened"}, ""},
+		{"Invalid", IssueParams{State: "closed", StateReason: "wontfix"}, `invalid issue params: unknown state_reason "wontfix"`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.p.Validate()
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRepoService_Issue(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		number           int
+		expectedIssue    *Issue
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			number:        1002,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/issues/1002", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			number:        1002,
+			expectedError: `GET /repos/octocat/Hello-World/issues/1002: 401 Bad credentials`,
+		},
+		{
+			name: "NotFound",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/issues/1002", 404, http.Header{}, `{
+					"message": "Not Found"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			number:        1002,
+			expectedError: `GET /repos/octocat/Hello-World/issues/1002: 404 Not Found`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/issues/1002", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			number:        1002,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/issues/1002", 200, header, issueBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			number:        1002,
+			expectedIssue: &issue2,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			issue, resp, err := tc.s.Issue(tc.ctx, tc.number)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, issue)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedIssue, issue)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_UpdateIssue(t *testing.T) {
+	closedIssue := issue1
+	closedIssue.State = "closed"
+	closedIssue.StateReason = "not_planned"
+	closedIssue.ClosedAt = parseGitHubTimePtr("2020-10-21T21:00:00Z")
+
+	closedIssueBody, _ := json.Marshal(closedIssue)
+
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		number        int
+		params        IssueParams
+		expectedIssue *Issue
+		expectedError string
+	}{
+		{
+			name:   "NilContext",
+			ctx:    nil,
+			number: 1001,
+			params: IssueParams{
+				State:       "closed",
+				StateReason: "not_planned",
+			},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name:   "InvalidParams",
+			ctx:    context.Background(),
+			number: 1001,
+			params: IssueParams{
+				State:       "closed",
+				StateReason: "duplicate",
+			},
+			expectedError: `invalid issue params: unknown state_reason "duplicate"`,
+		},
+		{
+			name:   "InvalidStatusCode",
+			ctx:    context.Background(),
+			number: 404,
+			params: IssueParams{
+				State:       "closed",
+				StateReason: "not_planned",
+			},
+			expectedError: `PATCH /repos/octocat/Hello-World/issues/404: 404 Not Found`,
+		},
+		{
+			name:   "Success",
+			ctx:    context.Background(),
+			number: 1001,
+			params: IssueParams{
+				State:       "closed",
+				StateReason: "not_planned",
+			},
+			expectedIssue: &closedIssue,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/repos/octocat/Hello-World/issues/1001" {
+					w.WriteHeader(404)
+					_, _ = io.WriteString(w, `{"message": "Not Found"}`)
+					return
+				}
+
+				body := map[string]interface{}{}
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				assert.Equal(t, map[string]interface{}{
+					"state":        "closed",
+					"state_reason": "not_planned",
+				}, body)
+
+				for k, vals := range header {
+					w.Header()[k] = vals
+				}
+				_, _ = w.Write(closedIssueBody)
+			}))
+			defer ts.Close()
+
+			c := &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+			}
+			c.apiURL, _ = url.Parse(ts.URL)
+
+			s := &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			}
+
+			issue, resp, err := s.UpdateIssue(tc.ctx, tc.number, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, issue)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedIssue, issue)
+				assert.NotNil(t, resp)
+				assert.Equal(t, expectedRate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_Pull(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		number           int
+		expectedPull     *Pull
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			number:        1002,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/pulls/1002", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			number:        1002,
+			expectedError: `GET /repos/octocat/Hello-World/pulls/1002: 401 Bad credentials`,
+		},
+		{
+			name: "ّInvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/pulls/1002", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			number:        1002,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/pulls/1002", 200, header, pullBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:          context.Background(),
+			number:       1002,
+			expectedPull: &pull,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			pull, resp, err := tc.s.Pull(tc.ctx, tc.number)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, pull)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedPull, pull)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_PullDiff(t *testing.T) {
+	diff := `diff --git a/README.md b/README.md
+index 2f1c8a6..c1a0f7e 100644
+--- a/README.md
++++ b/README.md
+@@ -1 +1 @@
+-Hello World
++Hello, World!
+`
+
+	patch := `From 6dcb09b5b57875f334f61aebed695e2e4193db5e Mon Sep 17 00:00:00 2001
+From: Monalisa Octocat <octocat@github.com>
+Date: Tue, 20 Oct 2020 20:00:00 +0000
+Subject: [PATCH] Fix greeting
+
+` + diff
+
+	tests := []struct {
+		name           string
+		get            func(*RepoService, context.Context, int) (string, *Response, error)
+		ctx            context.Context
+		number         int
+		expectedAccept string
+		statusCode     int
+		respBody       string
+		expectedText   string
+		expectedError  string
+	}{
+		{
+			name:          "NilContext",
+			get:           (*RepoService).PullDiff,
+			ctx:           nil,
+			number:        1002,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name:           "InvalidStatusCode",
+			get:            (*RepoService).PullDiff,
+			ctx:            context.Background(),
+			number:         1002,
+			expectedAccept: "application/vnd.github.v3.diff",
+			statusCode:     401,
+			respBody:       `{"message": "Bad credentials"}`,
+			expectedError:  `GET /repos/octocat/Hello-World/pulls/1002: 401 Bad credentials`,
+		},
+		{
+			name:           "Diff",
+			get:            (*RepoService).PullDiff,
+			ctx:            context.Background(),
+			number:         1002,
+			expectedAccept: "application/vnd.github.v3.diff",
+			statusCode:     200,
+			respBody:       diff,
+			expectedText:   diff,
+		},
+		{
+			name:           "Patch",
+			get:            (*RepoService).PullPatch,
+			ctx:            context.Background(),
+			number:         1002,
+			expectedAccept: "application/vnd.github.v3.patch",
+			statusCode:     200,
+			respBody:       patch,
+			expectedText:   patch,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "GET", r.Method)
+				assert.Equal(t, "/repos/octocat/Hello-World/pulls/1002", r.URL.Path)
+				assert.Equal(t, tc.expectedAccept, r.Header.Get("Accept"))
+
+				for k, vals := range header {
+					w.Header()[k] = vals
+				}
+				w.WriteHeader(tc.statusCode)
+				_, _ = io.WriteString(w, tc.respBody)
+			}))
+			defer ts.Close()
+
+			c := &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+			}
+			c.apiURL, _ = url.Parse(ts.URL)
+
+			s := &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			}
+
+			text, resp, err := tc.get(s, tc.ctx, tc.number)
+
+			if tc.expectedError != "" {
+				assert.Empty(t, text)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedText, text)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, expectedRate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_Pulls(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		pageSize         int
+		pageNo           int
+		params           PullsParams
+		expectedPulls    []Pull
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:      nil,
+			pageSize: 10,
+			pageNo:   1,
+			params: PullsParams{
+				State: "closed",
+			},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/pulls", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:      context.Background(),
+			pageSize: 10,
+			pageNo:   1,
+			params: PullsParams{
+				State: "closed",
+			},
+			expectedError: `GET /repos/octocat/Hello-World/pulls: 401 Bad credentials`,
+		},
+		{
+			name: "ّInvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/pulls", 200, http.Header{}, `[`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:      context.Background(),
+			pageSize: 10,
+			pageNo:   1,
+			params: PullsParams{
+				State: "closed",
+			},
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/pulls", 200, header, pullsBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:      context.Background(),
+			pageSize: 10,
+			pageNo:   1,
+			params: PullsParams{
+				State: "closed",
+			},
+			expectedPulls: []Pull{pull},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			pulls, resp, err := tc.s.Pulls(tc.ctx, tc.pageSize, tc.pageNo, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, pulls)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedPulls, pulls)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
 	}
+}
 
-	issue2 = Issue{
-		ID:     2,
-		Number: 1002,
-		State:  "closed",
-		Locked: false,
-		Title:  "Fixed a bug",
-		Body:   "I made this to work as expected!",
-		User: User{
-			ID:      2,
-			Login:   "octodog",
-			Type:    "User",
-			URL:     "https://api.github.com/users/octodog",
-			HTMLURL: "https://github.com/octodog",
-		},
-		Labels: []Label{
-			{
-				ID:      2000,
-				Name:    "bug",
-				Default: true,
+func TestRepoService_Pulls_Query(t *testing.T) {
+	tests := []struct {
+		name          string
+		params        PullsParams
+		expectedQuery url.Values
+	}{
+		{
+			name:   "NoParams",
+			params: PullsParams{},
+			expectedQuery: url.Values{
+				"per_page": {"10"},
+				"page":     {"1"},
 			},
 		},
-		Milestone: &Milestone{
-			ID:     3000,
-			Number: 1,
-			State:  "open",
-			Title:  "v1.0",
+		{
+			name: "AllParams",
+			params: PullsParams{
+				State:     "open",
+				Head:      "octocat:new-topic",
+				Base:      "main",
+				Sort:      "updated",
+				Direction: "desc",
+			},
+			expectedQuery: url.Values{
+				"per_page":  {"10"},
+				"page":      {"1"},
+				"state":     {"open"},
+				"head":      {"octocat:new-topic"},
+				"base":      {"main"},
+				"sort":      {"updated"},
+				"direction": {"desc"},
+			},
 		},
-		URL:     "https://api.github.com/repos/octocat/Hello-World/issues/1002",
-		HTMLURL: "https://github.com/octocat/Hello-World/pull/1002",
-		PullURLs: &PullURLs{
-			URL: "https://api.github.com/repos/octocat/Hello-World/pulls/1002",
+		{
+			name: "Merged",
+			params: PullsParams{
+				Base:        "main",
+				Sort:        "created",
+				Direction:   "asc",
+				MergedSince: parseGitHubTime("2020-10-01T00:00:00Z"),
+			},
+			expectedQuery: url.Values{
+				"per_page":  {"10"},
+				"page":      {"1"},
+				"state":     {"closed"},
+				"base":      {"main"},
+				"sort":      {"updated"},
+				"direction": {"desc"},
+			},
 		},
-		CreatedAt: parseGitHubTime("2020-10-15T15:00:00Z"),
-		UpdatedAt: parseGitHubTime("2020-10-22T22:00:00Z"),
-		ClosedAt:  parseGitHubTimePtr("2020-10-20T20:00:00Z"),
 	}
 
-	pull = Pull{
-		ID:     1,
-		Number: 1002,
-		State:  "closed",
-		Draft:  false,
-		Locked: false,
-		Title:  "Fixed a bug",
-		Body:   "I made this to work as expected!",
-		User: User{
-			ID:      2,
-			Login:   "octodog",
-			Type:    "User",
-			URL:     "https://api.github.com/users/octodog",
-			HTMLURL: "https://github.com/octodog",
-		},
-		Labels: []Label{
-			{
-				ID:      2000,
-				Name:    "bug",
-				Default: true,
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var query url.Values
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query = r.URL.Query()
+				_, _ = io.WriteString(w, `[]`)
+			}))
+			defer ts.Close()
+
+			c := &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+			}
+			c.apiURL, _ = url.Parse(ts.URL)
+
+			s := &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			}
+
+			pulls, _, err := s.Pulls(context.Background(), 10, 1, tc.params)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []Pull{}, pulls)
+			assert.Equal(t, tc.expectedQuery, query)
+		})
+	}
+}
+
+func TestRepoService_Pulls_Merged(t *testing.T) {
+	newPull := func(number int, updatedAt string, mergedAt *time.Time) Pull {
+		return Pull{
+			Number:    number,
+			State:     "closed",
+			UpdatedAt: parseGitHubTime(updatedAt),
+			MergedAt:  mergedAt,
+		}
+	}
+
+	pull1 := newPull(1004, "2020-10-26T10:00:00Z", parseGitHubTimePtr("2020-10-25T10:00:00Z"))
+	pull2 := newPull(1003, "2020-10-20T10:00:00Z", nil)
+	pull3 := newPull(1002, "2020-10-12T10:00:00Z", parseGitHubTimePtr("2020-10-10T10:00:00Z"))
+	pull4 := newPull(1001, "2020-09-21T10:00:00Z", parseGitHubTimePtr("2020-09-20T10:00:00Z"))
+
+	body, _ := json.Marshal([]Pull{pull1, pull2, pull3, pull4})
+
+	tests := []struct {
+		name          string
+		params        PullsParams
+		expectedPulls []Pull
+		expectedPages Pages
+	}{
+		{
+			name: "Range",
+			params: PullsParams{
+				MergedSince: parseGitHubTime("2020-10-01T00:00:00Z"),
+				MergedUntil: parseGitHubTime("2020-10-31T00:00:00Z"),
 			},
+			expectedPulls: []Pull{pull1, pull3},
+			expectedPages: Pages{First: 1, Prev: 2},
 		},
-		Milestone: &Milestone{
-			ID:     3000,
-			Number: 1,
-			State:  "open",
-			Title:  "v1.0",
-		},
-		Base: PullBranch{
-			Label: "octodog:master",
-			Ref:   "master",
-			SHA:   "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		{
+			name: "SinceOnly",
+			params: PullsParams{
+				MergedSince: parseGitHubTime("2020-10-15T00:00:00Z"),
+			},
+			expectedPulls: []Pull{pull1},
+			expectedPages: Pages{First: 1, Prev: 2},
 		},
-		Head: PullBranch{
-			Label: "octodog:new-topic",
-			Ref:   "new-topic",
-			SHA:   "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		{
+			name: "UntilOnly",
+			params: PullsParams{
+				MergedUntil: parseGitHubTime("2020-10-15T00:00:00Z"),
+			},
+			expectedPulls: []Pull{pull3, pull4},
+			expectedPages: expectedPages,
 		},
-		Merged:     true,
-		Mergeable:  nil,
-		Rebaseable: nil,
-		MergedBy: &User{
-			ID:      3,
-			Login:   "octofox",
-			Type:    "User",
-			URL:     "https://api.github.com/users/octofox",
-			HTMLURL: "https://github.com/octofox",
+		{
+			name: "SinceBeforeOldest",
+			params: PullsParams{
+				State:       "open",
+				MergedSince: parseGitHubTime("2020-09-01T00:00:00Z"),
+			},
+			expectedPulls: []Pull{pull1, pull3, pull4},
+			expectedPages: expectedPages,
 		},
-		MergeCommitSHA: "e5bd3914e2e596debea16f433f57875b5b90bcd6",
-		URL:            "https://api.github.com/repos/octocat/Hello-World/pulls/1002",
-		HTMLURL:        "https://github.com/octocat/Hello-World/pull/1002",
-		CreatedAt:      parseGitHubTime("2020-10-15T15:00:00Z"),
-		UpdatedAt:      parseGitHubTime("2020-10-22T22:00:00Z"),
-		ClosedAt:       parseGitHubTimePtr("2020-10-20T20:00:00Z"),
-		MergedAt:       parseGitHubTimePtr("2020-10-20T20:00:00Z"),
 	}
 
-	event1 = Event{
-		ID:       1,
-		Event:    "closed",
-		CommitID: "",
-		Actor: User{
-			ID:      1,
-			Login:   "octocat",
-			Type:    "User",
-			URL:     "https://api.github.com/users/octocat",
-			HTMLURL: "https://github.com/octocat",
-		},
-		CreatedAt: parseGitHubTime("2020-10-20T20:00:00Z"),
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				q := r.URL.Query()
+				if q.Get("state") != "closed" || q.Get("sort") != "updated" || q.Get("direction") != "desc" {
+					w.WriteHeader(400)
+					_, _ = io.WriteString(w, `{"message": "Unexpected query"}`)
+					return
+				}
+
+				for k, vals := range header {
+					w.Header()[k] = vals
+				}
+				_, _ = w.Write(body)
+			}))
+			defer ts.Close()
+
+			c := &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+			}
+			c.apiURL, _ = url.Parse(ts.URL)
+
+			s := &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			}
+
+			pulls, resp, err := s.Pulls(context.Background(), 10, 1, tc.params)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedPulls, pulls)
+			assert.NotNil(t, resp)
+			assert.Equal(t, tc.expectedPages, resp.Pages)
+			assert.Equal(t, expectedRate, resp.Rate)
+		})
 	}
+}
 
-	event2 = Event{
-		ID:       2,
-		Event:    "merged",
-		CommitID: "6dcb09b5b57875f334f61aebed695e2e4193db5e",
-		Actor: User{
-			ID:      3,
-			Login:   "octofox",
-			Type:    "User",
-			URL:     "https://api.github.com/users/octofox",
-			HTMLURL: "https://github.com/octofox",
-		},
-		CreatedAt: parseGitHubTime("2020-10-20T20:00:00Z"),
+func TestRepoService_OpenPullsByBase(t *testing.T) {
+	newPull := func(number int, base string) Pull {
+		return Pull{
+			Number: number,
+			State:  "open",
+			Base: PullBranch{
+				Ref: base,
+			},
+		}
 	}
 
-	release = Release{
-		ID:         1,
-		Name:       "v1.0.0",
-		TagName:    "v1.0.0",
-		Target:     "main",
-		Draft:      false,
-		Prerelease: false,
-		Body:       "Description of the release",
-		Author: User{
-			ID:    1,
-			Login: "octocat",
-			Type:  "User",
+	page1, _ := json.Marshal([]Pull{newPull(1004, "main"), newPull(1003, "release-1.0")})
+	page2, _ := json.Marshal([]Pull{newPull(1002, "main")})
+
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name           string
+		handler        http.HandlerFunc
+		ctx            context.Context
+		expectedCounts map[string]int
+		expectedError  string
+	}{
+		{
+			name:          "NilContext",
+			handler:       func(w http.ResponseWriter, r *http.Request) {},
+			ctx:           nil,
+			expectedError: `net/http: nil Context`,
 		},
-		Assets: []ReleaseAsset{
-			{
-				ID:          1,
-				Name:        "example.zip",
-				Label:       "short description",
-				State:       "uploaded",
-				ContentType: "application/zip",
-				Size:        1024,
-				Uploader: User{
-					ID:    1,
-					Login: "octocat",
-					Type:  "User",
-				},
+		{
+			name: "InvalidStatusCode",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(401)
+				_, _ = io.WriteString(w, `{"message": "Bad credentials"}`)
+			},
+			ctx:           context.Background(),
+			expectedError: `GET /repos/octocat/Hello-World/pulls: 401 Bad credentials`,
+		},
+		{
+			name: "CancelledContext",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set(headerLink, `<https://api.github.com/repositories/100/pulls?page=2>; rel="next", <https://api.github.com/repositories/100/pulls?page=2>; rel="last"`)
+				_, _ = w.Write(page1)
 			},
+			ctx:           cancelledCtx,
+			expectedError: `context canceled`,
 		},
-	}
+		{
+			name: "Success",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Query().Get("state") != "open" {
+					w.WriteHeader(400)
+					_, _ = io.WriteString(w, `{"message": "Unexpected query"}`)
+					return
+				}
 
-	releaseAsset = ReleaseAsset{
-		ID:          1,
-		Name:        "example.zip",
-		Label:       "short description",
-		State:       "uploaded",
-		ContentType: "application/zip",
-		Size:        1024,
-		Uploader: User{
-			ID:    1,
-			Login: "octocat",
-			Type:  "User",
+				for k, vals := range header {
+					if k != headerLink {
+						w.Header()[k] = vals
+					}
+				}
+
+				if r.URL.Query().Get("page") == "1" {
+					w.Header().Set(headerLink, `<https://api.github.com/repositories/100/pulls?page=2>; rel="next", <https://api.github.com/repositories/100/pulls?page=2>; rel="last"`)
+					_, _ = w.Write(page1)
+				} else {
+					_, _ = w.Write(page2)
+				}
+			},
+			ctx: context.Background(),
+			expectedCounts: map[string]int{
+				"main":        2,
+				"release-1.0": 1,
+			},
 		},
 	}
-)
 
-func TestRepoService_Get(t *testing.T) {
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := httptest.NewServer(tc.handler)
+			defer ts.Close()
+
+			c := &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+			}
+			c.apiURL, _ = url.Parse(ts.URL)
+
+			s := &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			}
+
+			counts, resp, err := s.OpenPullsByBase(tc.ctx)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, counts)
+				assert.Nil(t, resp)
+				assert.Contains(t, err.Error(), tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedCounts, counts)
+				assert.NotNil(t, resp)
+				assert.Equal(t, Pages{}, resp.Pages)
+				assert.Equal(t, expectedRate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_RequestReviewers(t *testing.T) {
 	c := &Client{
 		httpClient: &http.Client{},
 		rates:      map[rateGroup]Rate{},
@@ -779,13 +9263,16 @@ func TestRepoService_Get(t *testing.T) {
 	}
 
 	tests := []struct {
-		name               string
-		mockResponses      []MockResponse
-		s                  *RepoService
-		ctx                context.Context
-		expectedRepository *Repository
-		expectedResponse   *Response
-		expectedError      string
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		number           int
+		reviewers        []string
+		teamReviewers    []string
+		expectedPull     *Pull
+		expectedResponse *Response
+		expectedError    string
 	}{
 		{
 			name:          "NilContext",
@@ -796,12 +9283,15 @@ func TestRepoService_Get(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           nil,
+			number:        1002,
+			reviewers:     []string{"octodog"},
+			teamReviewers: []string{"justice-league"},
 			expectedError: `net/http: nil Context`,
 		},
 		{
 			name: "InvalidStatusCode",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World", 401, http.Header{}, `{
+				{"POST", "/repos/octocat/Hello-World/pulls/1002/requested_reviewers", 401, http.Header{}, `{
 					"message": "Bad credentials"
 				}`},
 			},
@@ -811,12 +9301,32 @@ func TestRepoService_Get(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           context.Background(),
-			expectedError: `GET /repos/octocat/Hello-World: 401 Bad credentials`,
+			number:        1002,
+			reviewers:     []string{"octodog"},
+			teamReviewers: []string{"justice-league"},
+			expectedError: `POST /repos/octocat/Hello-World/pulls/1002/requested_reviewers: 401 Bad credentials`,
 		},
 		{
-			name: "ّInvalidResponse",
+			name: "InvalidReviewer",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World", 200, http.Header{}, `[`},
+				{"POST", "/repos/octocat/Hello-World/pulls/1002/requested_reviewers", 422, http.Header{}, `{
+					"message": "Reviews may only be requested from collaborators. One or more of the users or teams you specified is not a collaborator of the octocat/Hello-World repository."
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			number:        1002,
+			reviewers:     []string{"octonobody"},
+			expectedError: `POST /repos/octocat/Hello-World/pulls/1002/requested_reviewers: 422 Reviews may only be requested from collaborators. One or more of the users or teams you specified is not a collaborator of the octocat/Hello-World repository.`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/pulls/1002/requested_reviewers", 201, http.Header{}, `{`},
 			},
 			s: &RepoService{
 				client: c,
@@ -824,20 +9334,26 @@ func TestRepoService_Get(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           context.Background(),
+			number:        1002,
+			reviewers:     []string{"octodog"},
+			teamReviewers: []string{"justice-league"},
 			expectedError: `unexpected EOF`,
 		},
 		{
 			name: "Success",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World", 200, header, repositoryBody},
+				{"POST", "/repos/octocat/Hello-World/pulls/1002/requested_reviewers", 201, header, pullBody},
 			},
 			s: &RepoService{
 				client: c,
 				owner:  "octocat",
 				repo:   "Hello-World",
 			},
-			ctx:                context.Background(),
-			expectedRepository: &repository,
+			ctx:           context.Background(),
+			number:        1002,
+			reviewers:     []string{"octodog"},
+			teamReviewers: []string{"justice-league"},
+			expectedPull:  &pull,
 			expectedResponse: &Response{
 				Rate: expectedRate,
 			},
@@ -849,15 +9365,15 @@ func TestRepoService_Get(t *testing.T) {
 			ts := newHTTPTestServer(tc.mockResponses...)
 			tc.s.client.apiURL, _ = url.Parse(ts.URL)
 
-			repository, resp, err := tc.s.Get(tc.ctx)
+			pull, resp, err := tc.s.RequestReviewers(tc.ctx, tc.number, tc.reviewers, tc.teamReviewers)
 
 			if tc.expectedError != "" {
-				assert.Nil(t, repository)
+				assert.Nil(t, pull)
 				assert.Nil(t, resp)
 				assert.EqualError(t, err, tc.expectedError)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, tc.expectedRepository, repository)
+				assert.Equal(t, tc.expectedPull, pull)
 				assert.NotNil(t, resp)
 				assert.NotNil(t, resp.Response)
 				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
@@ -866,7 +9382,7 @@ func TestRepoService_Get(t *testing.T) {
 	}
 }
 
-func TestRepoService_Permission(t *testing.T) {
+func TestRepoService_PullCommits(t *testing.T) {
 	c := &Client{
 		httpClient: &http.Client{},
 		rates:      map[rateGroup]Rate{},
@@ -874,14 +9390,16 @@ func TestRepoService_Permission(t *testing.T) {
 	}
 
 	tests := []struct {
-		name               string
-		mockResponses      []MockResponse
-		s                  *RepoService
-		ctx                context.Context
-		username           string
-		expectedPermission Permission
-		expectedResponse   *Response
-		expectedError      string
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		number           int
+		pageSize         int
+		pageNo           int
+		expectedCommits  []Commit
+		expectedResponse *Response
+		expectedError    string
 	}{
 		{
 			name:          "NilContext",
@@ -892,13 +9410,15 @@ func TestRepoService_Permission(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           nil,
-			username:      "octocat",
+			number:        1002,
+			pageSize:      10,
+			pageNo:        1,
 			expectedError: `net/http: nil Context`,
 		},
 		{
 			name: "InvalidStatusCode",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/collaborators/octocat/permission", 401, http.Header{}, `{
+				{"GET", "/repos/octocat/Hello-World/pulls/1002/commits", 401, http.Header{}, `{
 					"message": "Bad credentials"
 				}`},
 			},
@@ -908,13 +9428,15 @@ func TestRepoService_Permission(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           context.Background(),
-			username:      "octocat",
-			expectedError: `GET /repos/octocat/Hello-World/collaborators/octocat/permission: 401 Bad credentials`,
+			number:        1002,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /repos/octocat/Hello-World/pulls/1002/commits: 401 Bad credentials`,
 		},
 		{
-			name: "ّInvalidResponse",
+			name: "InvalidResponse",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/collaborators/octocat/permission", 200, http.Header{}, `[`},
+				{"GET", "/repos/octocat/Hello-World/pulls/1002/commits", 200, http.Header{}, `[`},
 			},
 			s: &RepoService{
 				client: c,
@@ -922,24 +9444,29 @@ func TestRepoService_Permission(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           context.Background(),
-			username:      "octocat",
+			number:        1002,
+			pageSize:      10,
+			pageNo:        1,
 			expectedError: `unexpected EOF`,
 		},
 		{
 			name: "Success",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/collaborators/octocat/permission", 200, header, permissionBody},
+				{"GET", "/repos/octocat/Hello-World/pulls/1002/commits", 200, header, commitsBody},
 			},
 			s: &RepoService{
 				client: c,
 				owner:  "octocat",
 				repo:   "Hello-World",
 			},
-			ctx:                context.Background(),
-			username:           "octocat",
-			expectedPermission: permission,
+			ctx:             context.Background(),
+			number:          1002,
+			pageSize:        10,
+			pageNo:          1,
+			expectedCommits: []Commit{commit2, commit1},
 			expectedResponse: &Response{
-				Rate: expectedRate,
+				Pages: expectedPages,
+				Rate:  expectedRate,
 			},
 		},
 	}
@@ -949,24 +9476,25 @@ func TestRepoService_Permission(t *testing.T) {
 			ts := newHTTPTestServer(tc.mockResponses...)
 			tc.s.client.apiURL, _ = url.Parse(ts.URL)
 
-			permission, resp, err := tc.s.Permission(tc.ctx, tc.username)
+			commits, resp, err := tc.s.PullCommits(tc.ctx, tc.number, tc.pageSize, tc.pageNo)
 
 			if tc.expectedError != "" {
-				assert.Empty(t, permission)
+				assert.Nil(t, commits)
 				assert.Nil(t, resp)
 				assert.EqualError(t, err, tc.expectedError)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, tc.expectedPermission, permission)
+				assert.Equal(t, tc.expectedCommits, commits)
 				assert.NotNil(t, resp)
 				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
 				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
 			}
 		})
 	}
 }
 
-func TestRepoService_Commit(t *testing.T) {
+func TestRepoService_PullFiles(t *testing.T) {
 	c := &Client{
 		httpClient: &http.Client{},
 		rates:      map[rateGroup]Rate{},
@@ -978,8 +9506,10 @@ func TestRepoService_Commit(t *testing.T) {
 		mockResponses    []MockResponse
 		s                *RepoService
 		ctx              context.Context
-		ref              string
-		expectedCommit   *Commit
+		number           int
+		pageSize         int
+		pageNo           int
+		expectedFiles    []PullFile
 		expectedResponse *Response
 		expectedError    string
 	}{
@@ -992,13 +9522,15 @@ func TestRepoService_Commit(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           nil,
-			ref:           "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			number:        1002,
+			pageSize:      10,
+			pageNo:        1,
 			expectedError: `net/http: nil Context`,
 		},
 		{
 			name: "InvalidStatusCode",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e", 401, http.Header{}, `{
+				{"GET", "/repos/octocat/Hello-World/pulls/1002/files", 401, http.Header{}, `{
 					"message": "Bad credentials"
 				}`},
 			},
@@ -1008,13 +9540,15 @@ func TestRepoService_Commit(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           context.Background(),
-			ref:           "6dcb09b5b57875f334f61aebed695e2e4193db5e",
-			expectedError: `GET /repos/octocat/Hello-World/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e: 401 Bad credentials`,
+			number:        1002,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /repos/octocat/Hello-World/pulls/1002/files: 401 Bad credentials`,
 		},
 		{
-			name: "ّInvalidResponse",
+			name: "InvalidResponse",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e", 200, http.Header{}, `{`},
+				{"GET", "/repos/octocat/Hello-World/pulls/1002/files", 200, http.Header{}, `[`},
 			},
 			s: &RepoService{
 				client: c,
@@ -1022,24 +9556,29 @@ func TestRepoService_Commit(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           context.Background(),
-			ref:           "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			number:        1002,
+			pageSize:      10,
+			pageNo:        1,
 			expectedError: `unexpected EOF`,
 		},
 		{
 			name: "Success",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e", 200, header, commitBody1},
+				{"GET", "/repos/octocat/Hello-World/pulls/1002/files", 200, header, pullFilesBody},
 			},
 			s: &RepoService{
 				client: c,
 				owner:  "octocat",
 				repo:   "Hello-World",
 			},
-			ctx:            context.Background(),
-			ref:            "6dcb09b5b57875f334f61aebed695e2e4193db5e",
-			expectedCommit: &commit1,
+			ctx:           context.Background(),
+			number:        1002,
+			pageSize:      10,
+			pageNo:        1,
+			expectedFiles: []PullFile{pullFile1, pullFile2},
 			expectedResponse: &Response{
-				Rate: expectedRate,
+				Pages: expectedPages,
+				Rate:  expectedRate,
 			},
 		},
 	}
@@ -1049,24 +9588,25 @@ func TestRepoService_Commit(t *testing.T) {
 			ts := newHTTPTestServer(tc.mockResponses...)
 			tc.s.client.apiURL, _ = url.Parse(ts.URL)
 
-			commit, resp, err := tc.s.Commit(tc.ctx, tc.ref)
+			files, resp, err := tc.s.PullFiles(tc.ctx, tc.number, tc.pageSize, tc.pageNo)
 
 			if tc.expectedError != "" {
-				assert.Nil(t, commit)
+				assert.Nil(t, files)
 				assert.Nil(t, resp)
 				assert.EqualError(t, err, tc.expectedError)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, tc.expectedCommit, commit)
+				assert.Equal(t, tc.expectedFiles, files)
 				assert.NotNil(t, resp)
 				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
 				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
 			}
 		})
 	}
 }
 
-func TestRepoService_Commits(t *testing.T) {
+func TestRepoService_PullReviews(t *testing.T) {
 	c := &Client{
 		httpClient: &http.Client{},
 		rates:      map[rateGroup]Rate{},
@@ -1078,9 +9618,10 @@ func TestRepoService_Commits(t *testing.T) {
 		mockResponses    []MockResponse
 		s                *RepoService
 		ctx              context.Context
+		number           int
 		pageSize         int
 		pageNo           int
-		expectedCommits  []Commit
+		expectedReviews  []Review
 		expectedResponse *Response
 		expectedError    string
 	}{
@@ -1093,6 +9634,7 @@ func TestRepoService_Commits(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           nil,
+			number:        1002,
 			pageSize:      10,
 			pageNo:        1,
 			expectedError: `net/http: nil Context`,
@@ -1100,7 +9642,7 @@ func TestRepoService_Commits(t *testing.T) {
 		{
 			name: "InvalidStatusCode",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/commits", 401, http.Header{}, `{
+				{"GET", "/repos/octocat/Hello-World/pulls/1002/reviews", 401, http.Header{}, `{
 					"message": "Bad credentials"
 				}`},
 			},
@@ -1110,14 +9652,15 @@ func TestRepoService_Commits(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           context.Background(),
+			number:        1002,
 			pageSize:      10,
 			pageNo:        1,
-			expectedError: `GET /repos/octocat/Hello-World/commits: 401 Bad credentials`,
+			expectedError: `GET /repos/octocat/Hello-World/pulls/1002/reviews: 401 Bad credentials`,
 		},
 		{
-			name: "ّInvalidResponse",
+			name: "InvalidResponse",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/commits", 200, http.Header{}, `[`},
+				{"GET", "/repos/octocat/Hello-World/pulls/1002/reviews", 200, http.Header{}, `[`},
 			},
 			s: &RepoService{
 				client: c,
@@ -1125,6 +9668,7 @@ func TestRepoService_Commits(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           context.Background(),
+			number:        1002,
 			pageSize:      10,
 			pageNo:        1,
 			expectedError: `unexpected EOF`,
@@ -1132,7 +9676,7 @@ func TestRepoService_Commits(t *testing.T) {
 		{
 			name: "Success",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/commits", 200, header, commitsBody},
+				{"GET", "/repos/octocat/Hello-World/pulls/1002/reviews", 200, header, reviewsBody},
 			},
 			s: &RepoService{
 				client: c,
@@ -1140,12 +9684,144 @@ func TestRepoService_Commits(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:             context.Background(),
+			number:          1002,
 			pageSize:        10,
 			pageNo:          1,
-			expectedCommits: []Commit{commit2, commit1},
+			expectedReviews: []Review{review1, review2},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			reviews, resp, err := tc.s.PullReviews(tc.ctx, tc.number, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, reviews)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedReviews, reviews)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_CreatePullReview(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		number           int
+		event            string
+		body             string
+		expectedReview   *Review
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			number:        1002,
+			event:         "APPROVE",
+			body:          "Looks good to me!",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/pulls/1002/reviews", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			number:        1002,
+			event:         "APPROVE",
+			body:          "Looks good to me!",
+			expectedError: `POST /repos/octocat/Hello-World/pulls/1002/reviews: 401 Bad credentials`,
+		},
+		{
+			name: "ApproveOwnPull",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/pulls/1002/reviews", 422, http.Header{}, `{
+					"message": "Unprocessable Entity",
+					"errors": [
+						"Can not approve your own pull request"
+					]
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			number:        1002,
+			event:         "APPROVE",
+			expectedError: `POST /repos/octocat/Hello-World/pulls/1002/reviews: 422 Unprocessable Entity (Can not approve your own pull request)`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/pulls/1002/reviews", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			number:        1002,
+			event:         "APPROVE",
+			body:          "Looks good to me!",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/pulls/1002/reviews", 200, header, reviewBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:            context.Background(),
+			number:         1002,
+			event:          "APPROVE",
+			body:           "Looks good to me!",
+			expectedReview: &review1,
 			expectedResponse: &Response{
-				Pages: expectedPages,
-				Rate:  expectedRate,
+				Rate: expectedRate,
 			},
 		},
 	}
@@ -1155,25 +9831,24 @@ func TestRepoService_Commits(t *testing.T) {
 			ts := newHTTPTestServer(tc.mockResponses...)
 			tc.s.client.apiURL, _ = url.Parse(ts.URL)
 
-			commits, resp, err := tc.s.Commits(tc.ctx, tc.pageSize, tc.pageNo)
+			review, resp, err := tc.s.CreatePullReview(tc.ctx, tc.number, tc.event, tc.body)
 
 			if tc.expectedError != "" {
-				assert.Nil(t, commits)
+				assert.Nil(t, review)
 				assert.Nil(t, resp)
 				assert.EqualError(t, err, tc.expectedError)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, tc.expectedCommits, commits)
+				assert.Equal(t, tc.expectedReview, review)
 				assert.NotNil(t, resp)
 				assert.NotNil(t, resp.Response)
-				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
 				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
 			}
 		})
 	}
 }
 
-func TestRepoService_Branch(t *testing.T) {
+func TestRepoService_Events(t *testing.T) {
 	c := &Client{
 		httpClient: &http.Client{},
 		rates:      map[rateGroup]Rate{},
@@ -1185,8 +9860,10 @@ func TestRepoService_Branch(t *testing.T) {
 		mockResponses    []MockResponse
 		s                *RepoService
 		ctx              context.Context
-		branchName       string
-		expectedBranch   *Branch
+		number           int
+		pageSize         int
+		pageNo           int
+		expectedEvents   []Event
 		expectedResponse *Response
 		expectedError    string
 	}{
@@ -1199,13 +9876,15 @@ func TestRepoService_Branch(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           nil,
-			branchName:    "main",
+			number:        1001,
+			pageSize:      10,
+			pageNo:        1,
 			expectedError: `net/http: nil Context`,
 		},
 		{
 			name: "InvalidStatusCode",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/branches/main", 401, http.Header{}, `{
+				{"GET", "/repos/octocat/Hello-World/issues/1001/events", 401, http.Header{}, `{
 					"message": "Bad credentials"
 				}`},
 			},
@@ -1215,13 +9894,15 @@ func TestRepoService_Branch(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           context.Background(),
-			branchName:    "main",
-			expectedError: `GET /repos/octocat/Hello-World/branches/main: 401 Bad credentials`,
+			number:        1001,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /repos/octocat/Hello-World/issues/1001/events: 401 Bad credentials`,
 		},
 		{
 			name: "ّInvalidResponse",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/branches/main", 200, http.Header{}, `{`},
+				{"GET", "/repos/octocat/Hello-World/issues/1001/events", 200, http.Header{}, `[`},
 			},
 			s: &RepoService{
 				client: c,
@@ -1229,13 +9910,15 @@ func TestRepoService_Branch(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           context.Background(),
-			branchName:    "main",
+			number:        1001,
+			pageSize:      10,
+			pageNo:        1,
 			expectedError: `unexpected EOF`,
 		},
 		{
 			name: "Success",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/branches/main", 200, header, branchBody},
+				{"GET", "/repos/octocat/Hello-World/issues/1001/events", 200, header, eventsBody},
 			},
 			s: &RepoService{
 				client: c,
@@ -1243,10 +9926,13 @@ func TestRepoService_Branch(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:            context.Background(),
-			branchName:     "main",
-			expectedBranch: &branch,
+			number:         1001,
+			pageSize:       10,
+			pageNo:         1,
+			expectedEvents: []Event{event2, event1},
 			expectedResponse: &Response{
-				Rate: expectedRate,
+				Pages: expectedPages,
+				Rate:  expectedRate,
 			},
 		},
 	}
@@ -1256,127 +9942,215 @@ func TestRepoService_Branch(t *testing.T) {
 			ts := newHTTPTestServer(tc.mockResponses...)
 			tc.s.client.apiURL, _ = url.Parse(ts.URL)
 
-			branch, resp, err := tc.s.Branch(tc.ctx, tc.branchName)
+			events, resp, err := tc.s.Events(tc.ctx, tc.number, tc.pageSize, tc.pageNo)
 
 			if tc.expectedError != "" {
-				assert.Nil(t, branch)
+				assert.Nil(t, events)
 				assert.Nil(t, resp)
 				assert.EqualError(t, err, tc.expectedError)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, tc.expectedBranch, branch)
+				assert.Equal(t, tc.expectedEvents, events)
 				assert.NotNil(t, resp)
 				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
 				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
 			}
 		})
 	}
 }
 
-func TestRepoService_BranchProtection(t *testing.T) {
-	c := &Client{
-		httpClient: &http.Client{},
-		rates:      map[rateGroup]Rate{},
-		apiURL:     publicAPIURL,
+func TestRepoService_Timeline(t *testing.T) {
+	timelineBody := `[
+		{
+			"id": 1,
+			"event": "labeled",
+			"actor": {
+				"id": 1,
+				"login": "octocat",
+				"type": "User"
+			},
+			"created_at": "2020-10-10T10:00:00Z",
+			"label": {
+				"name": "bug",
+				"color": "f29513"
+			}
+		},
+		{
+			"id": 2,
+			"event": "renamed",
+			"actor": {
+				"id": 1,
+				"login": "octocat",
+				"type": "User"
+			},
+			"created_at": "2020-10-20T20:00:00Z",
+			"rename": {
+				"from": "Found a bug",
+				"to": "Found a bug in login"
+			}
+		},
+		{
+			"event": "cross-referenced",
+			"actor": {
+				"id": 1,
+				"login": "octocat",
+				"type": "User"
+			},
+			"created_at": "2020-10-30T20:00:00Z",
+			"source": {
+				"type": "issue",
+				"issue": {
+					"id": 2,
+					"number": 1002,
+					"title": "Fix login"
+				}
+			}
+		}
+	]`
+
+	actor := User{
+		ID:    1,
+		Login: "octocat",
+		Type:  "User",
 	}
 
 	tests := []struct {
 		name             string
-		mockResponses    []MockResponse
-		s                *RepoService
 		ctx              context.Context
-		branch           string
-		enabled          bool
+		number           int
+		pageSize         int
+		pageNo           int
+		statusCode       int
+		respBody         string
+		expectedEvents   []TimelineEvent
 		expectedResponse *Response
 		expectedError    string
 	}{
 		{
 			name:          "NilContext",
-			mockResponses: []MockResponse{},
-			s: &RepoService{
-				client: c,
-				owner:  "octocat",
-				repo:   "Hello-World",
-			},
 			ctx:           nil,
-			branch:        "main",
-			enabled:       true,
+			number:        1001,
+			pageSize:      10,
+			pageNo:        1,
 			expectedError: `net/http: nil Context`,
 		},
 		{
-			name: "InvalidStatusCode",
-			mockResponses: []MockResponse{
-				{"POST", "/repos/octocat/Hello-World/branches/main/protection/enforce_admins", 401, http.Header{}, `{
-					"message": "Bad credentials"
-				}`},
-			},
-			s: &RepoService{
-				client: c,
-				owner:  "octocat",
-				repo:   "Hello-World",
-			},
+			name:          "InvalidStatusCode",
 			ctx:           context.Background(),
-			branch:        "main",
-			enabled:       true,
-			expectedError: `POST /repos/octocat/Hello-World/branches/main/protection/enforce_admins: 401 Bad credentials`,
+			number:        1001,
+			pageSize:      10,
+			pageNo:        1,
+			statusCode:    401,
+			respBody:      `{"message": "Bad credentials"}`,
+			expectedError: `GET /repos/octocat/Hello-World/issues/1001/timeline: 401 Bad credentials`,
 		},
 		{
-			name: "Success_Enable",
-			mockResponses: []MockResponse{
-				{"POST", "/repos/octocat/Hello-World/branches/main/protection/enforce_admins", 200, header, ``},
-			},
-			s: &RepoService{
-				client: c,
-				owner:  "octocat",
-				repo:   "Hello-World",
-			},
-			ctx:     context.Background(),
-			branch:  "main",
-			enabled: true,
-			expectedResponse: &Response{
-				Rate: expectedRate,
-			},
+			name:          "InvalidResponse",
+			ctx:           context.Background(),
+			number:        1001,
+			pageSize:      10,
+			pageNo:        1,
+			statusCode:    200,
+			respBody:      `[`,
+			expectedError: `unexpected EOF`,
 		},
 		{
-			name: "Success_Disable",
-			mockResponses: []MockResponse{
-				{"DELETE", "/repos/octocat/Hello-World/branches/main/protection/enforce_admins", 204, header, ``},
-			},
-			s: &RepoService{
-				client: c,
-				owner:  "octocat",
-				repo:   "Hello-World",
+			name:       "Success",
+			ctx:        context.Background(),
+			number:     1001,
+			pageSize:   10,
+			pageNo:     1,
+			statusCode: 200,
+			respBody:   timelineBody,
+			expectedEvents: []TimelineEvent{
+				{
+					ID:        1,
+					Event:     "labeled",
+					Actor:     actor,
+					CreatedAt: parseGitHubTime("2020-10-10T10:00:00Z"),
+					Label: &Label{
+						Name:  "bug",
+						Color: "f29513",
+					},
+				},
+				{
+					ID:        2,
+					Event:     "renamed",
+					Actor:     actor,
+					CreatedAt: parseGitHubTime("2020-10-20T20:00:00Z"),
+					Rename: &TimelineRename{
+						From: "Found a bug",
+						To:   "Found a bug in login",
+					},
+				},
+				{
+					Event:     "cross-referenced",
+					Actor:     actor,
+					CreatedAt: parseGitHubTime("2020-10-30T20:00:00Z"),
+					Source: &TimelineSource{
+						Type: "issue",
+						Issue: Issue{
+							ID:     2,
+							Number: 1002,
+							Title:  "Fix login",
+						},
+					},
+				},
 			},
-			ctx:     context.Background(),
-			branch:  "main",
-			enabled: false,
 			expectedResponse: &Response{
-				Rate: expectedRate,
+				Pages: expectedPages,
+				Rate:  expectedRate,
 			},
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			ts := newHTTPTestServer(tc.mockResponses...)
-			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "GET", r.Method)
+				assert.Equal(t, "/repos/octocat/Hello-World/issues/1001/timeline", r.URL.Path)
+				assert.Equal(t, "application/vnd.github.mockingbird-preview+json", r.Header.Get("Accept"))
 
-			resp, err := tc.s.BranchProtection(tc.ctx, tc.branch, tc.enabled)
+				for k, vals := range header {
+					w.Header()[k] = vals
+				}
+				w.WriteHeader(tc.statusCode)
+				_, _ = io.WriteString(w, tc.respBody)
+			}))
+			defer ts.Close()
+
+			c := &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+			}
+			c.apiURL, _ = url.Parse(ts.URL)
+
+			s := &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			}
+
+			events, resp, err := s.Timeline(tc.ctx, tc.number, tc.pageSize, tc.pageNo)
 
 			if tc.expectedError != "" {
+				assert.Nil(t, events)
 				assert.Nil(t, resp)
 				assert.EqualError(t, err, tc.expectedError)
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedEvents, events)
 				assert.NotNil(t, resp)
 				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
 				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
 			}
 		})
 	}
 }
 
-func TestRepoService_Tags(t *testing.T) {
+func TestRepoService_Releases(t *testing.T) {
 	c := &Client{
 		httpClient: &http.Client{},
 		rates:      map[rateGroup]Rate{},
@@ -1390,7 +10164,7 @@ func TestRepoService_Tags(t *testing.T) {
 		ctx              context.Context
 		pageSize         int
 		pageNo           int
-		expectedTags     []Tag
+		expectedReleases []Release
 		expectedResponse *Response
 		expectedError    string
 	}{
@@ -1410,7 +10184,7 @@ func TestRepoService_Tags(t *testing.T) {
 		{
 			name: "InvalidStatusCode",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/tags", 401, http.Header{}, `{
+				{"GET", "/repos/octocat/Hello-World/releases", 401, http.Header{}, `{
 					"message": "Bad credentials"
 				}`},
 			},
@@ -1422,12 +10196,12 @@ func TestRepoService_Tags(t *testing.T) {
 			ctx:           context.Background(),
 			pageSize:      10,
 			pageNo:        1,
-			expectedError: `GET /repos/octocat/Hello-World/tags: 401 Bad credentials`,
+			expectedError: `GET /repos/octocat/Hello-World/releases: 401 Bad credentials`,
 		},
 		{
-			name: "ّInvalidResponse",
+			name: "InvalidResponse",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/tags", 200, http.Header{}, `[`},
+				{"GET", "/repos/octocat/Hello-World/releases", 200, http.Header{}, `[`},
 			},
 			s: &RepoService{
 				client: c,
@@ -1442,17 +10216,17 @@ func TestRepoService_Tags(t *testing.T) {
 		{
 			name: "Success",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/tags", 200, header, tagsBody},
+				{"GET", "/repos/octocat/Hello-World/releases", 200, header, "[" + releaseBody + "]"},
 			},
 			s: &RepoService{
 				client: c,
 				owner:  "octocat",
 				repo:   "Hello-World",
 			},
-			ctx:          context.Background(),
-			pageSize:     10,
-			pageNo:       1,
-			expectedTags: []Tag{tag},
+			ctx:              context.Background(),
+			pageSize:         10,
+			pageNo:           1,
+			expectedReleases: []Release{release},
 			expectedResponse: &Response{
 				Pages: expectedPages,
 				Rate:  expectedRate,
@@ -1465,15 +10239,15 @@ func TestRepoService_Tags(t *testing.T) {
 			ts := newHTTPTestServer(tc.mockResponses...)
 			tc.s.client.apiURL, _ = url.Parse(ts.URL)
 
-			tags, resp, err := tc.s.Tags(tc.ctx, tc.pageSize, tc.pageNo)
+			releases, resp, err := tc.s.Releases(tc.ctx, tc.pageSize, tc.pageNo)
 
 			if tc.expectedError != "" {
-				assert.Nil(t, tags)
+				assert.Nil(t, releases)
 				assert.Nil(t, resp)
 				assert.EqualError(t, err, tc.expectedError)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, tc.expectedTags, tags)
+				assert.Equal(t, tc.expectedReleases, releases)
 				assert.NotNil(t, resp)
 				assert.NotNil(t, resp.Response)
 				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
@@ -1483,24 +10257,106 @@ func TestRepoService_Tags(t *testing.T) {
 	}
 }
 
-func TestRepoService_Issues(t *testing.T) {
+func TestRepoService_ReleasesWithAssets(t *testing.T) {
+	tagOnly := Release{
+		ID:      2,
+		Name:    "v0.1.0",
+		TagName: "v0.1.0",
+		Assets:  []ReleaseAsset{},
+	}
+
+	body, _ := json.Marshal([]Release{release, tagOnly})
+
+	tests := []struct {
+		name             string
+		handler          http.HandlerFunc
+		ctx              context.Context
+		pageSize         int
+		pageNo           int
+		expectedReleases []Release
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			handler:       func(w http.ResponseWriter, r *http.Request) {},
+			ctx:           nil,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(401)
+				_, _ = io.WriteString(w, `{"message": "Bad credentials"}`)
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /repos/octocat/Hello-World/releases: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				for k, vals := range header {
+					w.Header()[k] = vals
+				}
+				_, _ = w.Write(body)
+			},
+			ctx:              context.Background(),
+			pageSize:         10,
+			pageNo:           1,
+			expectedReleases: []Release{release},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := httptest.NewServer(tc.handler)
+			defer ts.Close()
+
+			c := &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+			}
+			c.apiURL, _ = url.Parse(ts.URL)
+
+			s := &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			}
+
+			releases, resp, err := s.ReleasesWithAssets(tc.ctx, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, releases)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedReleases, releases)
+				assert.NotNil(t, resp)
+				assert.Equal(t, expectedPages, resp.Pages)
+				assert.Equal(t, expectedRate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_LatestRelease(t *testing.T) {
 	c := &Client{
 		httpClient: &http.Client{},
 		rates:      map[rateGroup]Rate{},
 		apiURL:     publicAPIURL,
 	}
 
-	since, _ := time.Parse(time.RFC3339, "2020-10-20T22:30:00-04:00")
-
 	tests := []struct {
 		name             string
 		mockResponses    []MockResponse
 		s                *RepoService
 		ctx              context.Context
-		pageSize         int
-		pageNo           int
-		params           IssuesParams
-		expectedIssues   []Issue
+		expectedRelease  *Release
 		expectedResponse *Response
 		expectedError    string
 	}{
@@ -1512,19 +10368,13 @@ func TestRepoService_Issues(t *testing.T) {
 				owner:  "octocat",
 				repo:   "Hello-World",
 			},
-			ctx:      nil,
-			pageSize: 10,
-			pageNo:   1,
-			params: IssuesParams{
-				State: "closed",
-				Since: since,
-			},
+			ctx:           nil,
 			expectedError: `net/http: nil Context`,
 		},
 		{
 			name: "InvalidStatusCode",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/issues", 401, http.Header{}, `{
+				{"GET", "/repos/octocat/Hello-World/releases/latest", 401, http.Header{}, `{
 					"message": "Bad credentials"
 				}`},
 			},
@@ -1533,55 +10383,36 @@ func TestRepoService_Issues(t *testing.T) {
 				owner:  "octocat",
 				repo:   "Hello-World",
 			},
-			ctx:      context.Background(),
-			pageSize: 10,
-			pageNo:   1,
-			params: IssuesParams{
-				State: "closed",
-				Since: since,
-			},
-			expectedError: `GET /repos/octocat/Hello-World/issues: 401 Bad credentials`,
+			ctx:           context.Background(),
+			expectedError: `GET /repos/octocat/Hello-World/releases/latest: 401 Bad credentials`,
 		},
 		{
 			name: "ّInvalidResponse",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/issues", 200, http.Header{}, `[`},
+				{"GET", "/repos/octocat/Hello-World/releases/latest", 200, http.Header{}, `{`},
 			},
 			s: &RepoService{
 				client: c,
 				owner:  "octocat",
 				repo:   "Hello-World",
 			},
-			ctx:      context.Background(),
-			pageSize: 10,
-			pageNo:   1,
-			params: IssuesParams{
-				State: "closed",
-				Since: since,
-			},
+			ctx:           context.Background(),
 			expectedError: `unexpected EOF`,
 		},
 		{
 			name: "Success",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/issues", 200, header, issuesBody},
+				{"GET", "/repos/octocat/Hello-World/releases/latest", 200, header, releaseBody},
 			},
 			s: &RepoService{
 				client: c,
 				owner:  "octocat",
 				repo:   "Hello-World",
 			},
-			ctx:      context.Background(),
-			pageSize: 10,
-			pageNo:   1,
-			params: IssuesParams{
-				State: "closed",
-				Since: since,
-			},
-			expectedIssues: []Issue{issue2, issue1},
+			ctx:             context.Background(),
+			expectedRelease: &release,
 			expectedResponse: &Response{
-				Pages: expectedPages,
-				Rate:  expectedRate,
+				Rate: expectedRate,
 			},
 		},
 	}
@@ -1591,41 +10422,61 @@ func TestRepoService_Issues(t *testing.T) {
 			ts := newHTTPTestServer(tc.mockResponses...)
 			tc.s.client.apiURL, _ = url.Parse(ts.URL)
 
-			issues, resp, err := tc.s.Issues(tc.ctx, tc.pageSize, tc.pageNo, tc.params)
+			release, resp, err := tc.s.LatestRelease(tc.ctx)
 
 			if tc.expectedError != "" {
-				assert.Nil(t, issues)
+				assert.Nil(t, release)
 				assert.Nil(t, resp)
 				assert.EqualError(t, err, tc.expectedError)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, tc.expectedIssues, issues)
+				assert.Equal(t, tc.expectedRelease, release)
 				assert.NotNil(t, resp)
 				assert.NotNil(t, resp.Response)
-				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
 				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
 			}
 		})
 	}
 }
 
-func TestRepoService_Pull(t *testing.T) {
+func TestRepoService_CreateRelease(t *testing.T) {
 	c := &Client{
 		httpClient: &http.Client{},
 		rates:      map[rateGroup]Rate{},
 		apiURL:     publicAPIURL,
 	}
 
+	params := ReleaseParams{
+		Name:       "v1.0.0",
+		TagName:    "v1.0.0",
+		Target:     "main",
+		Draft:      false,
+		Prerelease: false,
+		Body:       "Description of the release",
+	}
+
 	tests := []struct {
 		name             string
 		mockResponses    []MockResponse
 		s                *RepoService
 		ctx              context.Context
-		number           int
-		expectedPull     *Pull
+		params           ReleaseParams
+		expectedRelease  *Release
 		expectedResponse *Response
 		expectedError    string
 	}{
+		{
+			name:          "InvalidParams",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			params:        ReleaseParams{},
+			expectedError: `invalid release params: tag_name is required`,
+		},
 		{
 			name:          "NilContext",
 			mockResponses: []MockResponse{},
@@ -1635,13 +10486,13 @@ func TestRepoService_Pull(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           nil,
-			number:        1002,
+			params:        params,
 			expectedError: `net/http: nil Context`,
 		},
 		{
 			name: "InvalidStatusCode",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/pulls/1002", 401, http.Header{}, `{
+				{"POST", "/repos/octocat/Hello-World/releases", 401, http.Header{}, `{
 					"message": "Bad credentials"
 				}`},
 			},
@@ -1651,13 +10502,13 @@ func TestRepoService_Pull(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           context.Background(),
-			number:        1002,
-			expectedError: `GET /repos/octocat/Hello-World/pulls/1002: 401 Bad credentials`,
+			params:        params,
+			expectedError: `POST /repos/octocat/Hello-World/releases: 401 Bad credentials`,
 		},
 		{
 			name: "ّInvalidResponse",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/pulls/1002", 200, http.Header{}, `{`},
+				{"POST", "/repos/octocat/Hello-World/releases", 201, http.Header{}, `{`},
 			},
 			s: &RepoService{
 				client: c,
@@ -1665,22 +10516,22 @@ func TestRepoService_Pull(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           context.Background(),
-			number:        1002,
+			params:        params,
 			expectedError: `unexpected EOF`,
 		},
 		{
 			name: "Success",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/pulls/1002", 200, header, pullBody},
+				{"POST", "/repos/octocat/Hello-World/releases", 201, header, releaseBody},
 			},
 			s: &RepoService{
 				client: c,
 				owner:  "octocat",
 				repo:   "Hello-World",
 			},
-			ctx:          context.Background(),
-			number:       1002,
-			expectedPull: &pull,
+			ctx:             context.Background(),
+			params:          params,
+			expectedRelease: &release,
 			expectedResponse: &Response{
 				Rate: expectedRate,
 			},
@@ -1692,15 +10543,15 @@ func TestRepoService_Pull(t *testing.T) {
 			ts := newHTTPTestServer(tc.mockResponses...)
 			tc.s.client.apiURL, _ = url.Parse(ts.URL)
 
-			pull, resp, err := tc.s.Pull(tc.ctx, tc.number)
+			release, resp, err := tc.s.CreateRelease(tc.ctx, tc.params)
 
 			if tc.expectedError != "" {
-				assert.Nil(t, pull)
+				assert.Nil(t, release)
 				assert.Nil(t, resp)
 				assert.EqualError(t, err, tc.expectedError)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, tc.expectedPull, pull)
+				assert.Equal(t, tc.expectedRelease, release)
 				assert.NotNil(t, resp)
 				assert.NotNil(t, resp.Response)
 				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
@@ -1709,45 +10560,63 @@ func TestRepoService_Pull(t *testing.T) {
 	}
 }
 
-func TestRepoService_Pulls(t *testing.T) {
+func TestRepoService_UpdateRelease(t *testing.T) {
 	c := &Client{
 		httpClient: &http.Client{},
 		rates:      map[rateGroup]Rate{},
 		apiURL:     publicAPIURL,
 	}
 
+	params := ReleaseParams{
+		Name:       "v1.0.0",
+		TagName:    "v1.0.0",
+		Target:     "main",
+		Draft:      false,
+		Prerelease: false,
+		Body:       "Description of the release",
+	}
+
 	tests := []struct {
 		name             string
 		mockResponses    []MockResponse
 		s                *RepoService
 		ctx              context.Context
-		pageSize         int
-		pageNo           int
-		params           PullsParams
-		expectedPulls    []Pull
+		releaseID        int
+		params           ReleaseParams
+		expectedRelease  *Release
 		expectedResponse *Response
 		expectedError    string
 	}{
 		{
-			name:          "NilContext",
+			name:          "InvalidParams",
 			mockResponses: []MockResponse{},
 			s: &RepoService{
 				client: c,
 				owner:  "octocat",
 				repo:   "Hello-World",
 			},
-			ctx:      nil,
-			pageSize: 10,
-			pageNo:   1,
-			params: PullsParams{
-				State: "closed",
+			ctx:           nil,
+			releaseID:     1,
+			params:        ReleaseParams{},
+			expectedError: `invalid release params: tag_name is required`,
+		},
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
 			},
+			ctx:           nil,
+			releaseID:     1,
+			params:        params,
 			expectedError: `net/http: nil Context`,
 		},
 		{
 			name: "InvalidStatusCode",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/pulls", 401, http.Header{}, `{
+				{"PATCH", "/repos/octocat/Hello-World/releases/1", 401, http.Header{}, `{
 					"message": "Bad credentials"
 				}`},
 			},
@@ -1756,52 +10625,42 @@ func TestRepoService_Pulls(t *testing.T) {
 				owner:  "octocat",
 				repo:   "Hello-World",
 			},
-			ctx:      context.Background(),
-			pageSize: 10,
-			pageNo:   1,
-			params: PullsParams{
-				State: "closed",
-			},
-			expectedError: `GET /repos/octocat/Hello-World/pulls: 401 Bad credentials`,
+			ctx:           context.Background(),
+			releaseID:     1,
+			params:        params,
+			expectedError: `PATCH /repos/octocat/Hello-World/releases/1: 401 Bad credentials`,
 		},
 		{
 			name: "ّInvalidResponse",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/pulls", 200, http.Header{}, `[`},
+				{"PATCH", "/repos/octocat/Hello-World/releases/1", 200, http.Header{}, `{`},
 			},
 			s: &RepoService{
 				client: c,
 				owner:  "octocat",
 				repo:   "Hello-World",
 			},
-			ctx:      context.Background(),
-			pageSize: 10,
-			pageNo:   1,
-			params: PullsParams{
-				State: "closed",
-			},
+			ctx:           context.Background(),
+			releaseID:     1,
+			params:        params,
 			expectedError: `unexpected EOF`,
 		},
 		{
 			name: "Success",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/pulls", 200, header, pullsBody},
+				{"PATCH", "/repos/octocat/Hello-World/releases/1", 200, header, releaseBody},
 			},
 			s: &RepoService{
 				client: c,
 				owner:  "octocat",
 				repo:   "Hello-World",
 			},
-			ctx:      context.Background(),
-			pageSize: 10,
-			pageNo:   1,
-			params: PullsParams{
-				State: "closed",
-			},
-			expectedPulls: []Pull{pull},
+			ctx:             context.Background(),
+			releaseID:       1,
+			params:          params,
+			expectedRelease: &release,
 			expectedResponse: &Response{
-				Pages: expectedPages,
-				Rate:  expectedRate,
+				Rate: expectedRate,
 			},
 		},
 	}
@@ -1811,42 +10670,41 @@ func TestRepoService_Pulls(t *testing.T) {
 			ts := newHTTPTestServer(tc.mockResponses...)
 			tc.s.client.apiURL, _ = url.Parse(ts.URL)
 
-			pulls, resp, err := tc.s.Pulls(tc.ctx, tc.pageSize, tc.pageNo, tc.params)
+			release, resp, err := tc.s.UpdateRelease(tc.ctx, tc.releaseID, tc.params)
 
 			if tc.expectedError != "" {
-				assert.Nil(t, pulls)
+				assert.Nil(t, release)
 				assert.Nil(t, resp)
 				assert.EqualError(t, err, tc.expectedError)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, tc.expectedPulls, pulls)
+				assert.Equal(t, tc.expectedRelease, release)
 				assert.NotNil(t, resp)
 				assert.NotNil(t, resp.Response)
-				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
 				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
 			}
 		})
 	}
 }
 
-func TestRepoService_Events(t *testing.T) {
+func TestRepoService_UploadReleaseAsset(t *testing.T) {
 	c := &Client{
 		httpClient: &http.Client{},
 		rates:      map[rateGroup]Rate{},
-		apiURL:     publicAPIURL,
+		uploadURL:  publicUploadURL,
 	}
 
 	tests := []struct {
-		name             string
-		mockResponses    []MockResponse
-		s                *RepoService
-		ctx              context.Context
-		number           int
-		pageSize         int
-		pageNo           int
-		expectedEvents   []Event
-		expectedResponse *Response
-		expectedError    string
+		name                 string
+		mockResponses        []MockResponse
+		s                    *RepoService
+		ctx                  context.Context
+		releaseID            int
+		assetFile            string
+		assetLabel           string
+		expectedReleaseAsset *ReleaseAsset
+		expectedResponse     *Response
+		expectedError        string
 	}{
 		{
 			name:          "NilContext",
@@ -1857,15 +10715,43 @@ func TestRepoService_Events(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           nil,
-			number:        1001,
-			pageSize:      10,
-			pageNo:        1,
+			releaseID:     1,
+			assetFile:     "repo_test.go",
+			assetLabel:    "test",
 			expectedError: `net/http: nil Context`,
 		},
+		{
+			name:          "NoFile",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			releaseID:     1,
+			assetFile:     "unknown",
+			assetLabel:    "test",
+			expectedError: `open unknown: no such file or directory`,
+		},
+		{
+			name:          "BadFile",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			releaseID:     1,
+			assetFile:     "/dev/null",
+			assetLabel:    "test",
+			expectedError: `EOF`,
+		},
 		{
 			name: "InvalidStatusCode",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/issues/1001/events", 401, http.Header{}, `{
+				{"POST", "/repos/octocat/Hello-World/releases/1/assets", 401, http.Header{}, `{
 					"message": "Bad credentials"
 				}`},
 			},
@@ -1875,15 +10761,15 @@ func TestRepoService_Events(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           context.Background(),
-			number:        1001,
-			pageSize:      10,
-			pageNo:        1,
-			expectedError: `GET /repos/octocat/Hello-World/issues/1001/events: 401 Bad credentials`,
+			releaseID:     1,
+			assetFile:     "repo_test.go",
+			assetLabel:    "test",
+			expectedError: `POST /repos/octocat/Hello-World/releases/1/assets: 401 Bad credentials`,
 		},
 		{
 			name: "ّInvalidResponse",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/issues/1001/events", 200, http.Header{}, `[`},
+				{"POST", "/repos/octocat/Hello-World/releases/1/assets", 201, http.Header{}, `{`},
 			},
 			s: &RepoService{
 				client: c,
@@ -1891,29 +10777,28 @@ func TestRepoService_Events(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           context.Background(),
-			number:        1001,
-			pageSize:      10,
-			pageNo:        1,
+			releaseID:     1,
+			assetFile:     "repo_test.go",
+			assetLabel:    "test",
 			expectedError: `unexpected EOF`,
 		},
 		{
 			name: "Success",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/issues/1001/events", 200, header, eventsBody},
+				{"POST", "/repos/octocat/Hello-World/releases/1/assets", 201, header, releaseAssetBody},
 			},
 			s: &RepoService{
 				client: c,
 				owner:  "octocat",
 				repo:   "Hello-World",
 			},
-			ctx:            context.Background(),
-			number:         1001,
-			pageSize:       10,
-			pageNo:         1,
-			expectedEvents: []Event{event2, event1},
+			ctx:                  context.Background(),
+			releaseID:            1,
+			assetFile:            "repo_test.go",
+			assetLabel:           "test",
+			expectedReleaseAsset: &releaseAsset,
 			expectedResponse: &Response{
-				Pages: expectedPages,
-				Rate:  expectedRate,
+				Rate: expectedRate,
 			},
 		},
 	}
@@ -1921,31 +10806,30 @@ func TestRepoService_Events(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			ts := newHTTPTestServer(tc.mockResponses...)
-			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+			tc.s.client.uploadURL, _ = url.Parse(ts.URL)
 
-			events, resp, err := tc.s.Events(tc.ctx, tc.number, tc.pageSize, tc.pageNo)
+			asset, resp, err := tc.s.UploadReleaseAsset(tc.ctx, tc.releaseID, tc.assetFile, tc.assetLabel)
 
 			if tc.expectedError != "" {
-				assert.Nil(t, events)
+				assert.Nil(t, asset)
 				assert.Nil(t, resp)
 				assert.EqualError(t, err, tc.expectedError)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, tc.expectedEvents, events)
+				assert.Equal(t, tc.expectedReleaseAsset, asset)
 				assert.NotNil(t, resp)
 				assert.NotNil(t, resp.Response)
-				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
 				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
 			}
 		})
 	}
 }
 
-func TestRepoService_LatestRelease(t *testing.T) {
+func TestRepoService_DownloadReleaseAsset(t *testing.T) {
 	c := &Client{
-		httpClient: &http.Client{},
-		rates:      map[rateGroup]Rate{},
-		apiURL:     publicAPIURL,
+		httpClient:  &http.Client{},
+		rates:       map[rateGroup]Rate{},
+		downloadURL: publicDownloadURL,
 	}
 
 	tests := []struct {
@@ -1953,7 +10837,9 @@ func TestRepoService_LatestRelease(t *testing.T) {
 		mockResponses    []MockResponse
 		s                *RepoService
 		ctx              context.Context
-		expectedRelease  *Release
+		releaseTag       string
+		assetName        string
+		w                io.Writer
 		expectedResponse *Response
 		expectedError    string
 	}{
@@ -1966,27 +10852,15 @@ func TestRepoService_LatestRelease(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           nil,
+			releaseTag:    "v1.0.0",
+			assetName:     "example.zip",
+			w:             nil,
 			expectedError: `net/http: nil Context`,
 		},
 		{
 			name: "InvalidStatusCode",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/releases/latest", 401, http.Header{}, `{
-					"message": "Bad credentials"
-				}`},
-			},
-			s: &RepoService{
-				client: c,
-				owner:  "octocat",
-				repo:   "Hello-World",
-			},
-			ctx:           context.Background(),
-			expectedError: `GET /repos/octocat/Hello-World/releases/latest: 401 Bad credentials`,
-		},
-		{
-			name: "ّInvalidResponse",
-			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/releases/latest", 200, http.Header{}, `{`},
+				{"GET", "/octocat/Hello-World/releases/download/v1.0.0/example.zip", 401, http.Header{}, ``},
 			},
 			s: &RepoService{
 				client: c,
@@ -1994,20 +10868,25 @@ func TestRepoService_LatestRelease(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           context.Background(),
-			expectedError: `unexpected EOF`,
+			releaseTag:    "v1.0.0",
+			assetName:     "example.zip",
+			w:             ioutil.Discard,
+			expectedError: `GET /octocat/Hello-World/releases/download/v1.0.0/example.zip: 401 `,
 		},
 		{
 			name: "Success",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/releases/latest", 200, header, releaseBody},
+				{"GET", "/octocat/Hello-World/releases/download/v1.0.0/example.zip", 200, header, `content`},
 			},
 			s: &RepoService{
 				client: c,
 				owner:  "octocat",
 				repo:   "Hello-World",
 			},
-			ctx:             context.Background(),
-			expectedRelease: &release,
+			ctx:        context.Background(),
+			releaseTag: "v1.0.0",
+			assetName:  "example.zip",
+			w:          ioutil.Discard,
 			expectedResponse: &Response{
 				Rate: expectedRate,
 			},
@@ -2017,17 +10896,15 @@ func TestRepoService_LatestRelease(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			ts := newHTTPTestServer(tc.mockResponses...)
-			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+			tc.s.client.downloadURL, _ = url.Parse(ts.URL)
 
-			release, resp, err := tc.s.LatestRelease(tc.ctx)
+			resp, err := tc.s.DownloadReleaseAsset(tc.ctx, tc.releaseTag, tc.assetName, tc.w)
 
 			if tc.expectedError != "" {
-				assert.Nil(t, release)
 				assert.Nil(t, resp)
 				assert.EqualError(t, err, tc.expectedError)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, tc.expectedRelease, release)
 				assert.NotNil(t, resp)
 				assert.NotNil(t, resp.Response)
 				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
@@ -2036,141 +10913,116 @@ func TestRepoService_LatestRelease(t *testing.T) {
 	}
 }
 
-func TestRepoService_CreateRelease(t *testing.T) {
-	c := &Client{
-		httpClient: &http.Client{},
-		rates:      map[rateGroup]Rate{},
-		apiURL:     publicAPIURL,
-	}
+func TestRepoService_DownloadReleaseAssetByID(t *testing.T) {
+	// The storage server is reached through a different host name than the API server.
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/github-production-release-asset/1", r.URL.Path)
+		assert.Empty(t, r.Header.Get("Authorization"))
 
-	params := ReleaseParams{
-		Name:       "v1.0.0",
-		TagName:    "v1.0.0",
-		Target:     "main",
-		Draft:      false,
-		Prerelease: false,
-		Body:       "Description of the release",
-	}
+		_, _ = io.WriteString(w, "content")
+	}))
+	defer storage.Close()
+
+	storageURL, _ := url.Parse(storage.URL)
+	storageURL.Host = strings.Replace(storageURL.Host, "127.0.0.1", "localhost", 1)
 
 	tests := []struct {
-		name             string
-		mockResponses    []MockResponse
-		s                *RepoService
-		ctx              context.Context
-		params           ReleaseParams
-		expectedRelease  *Release
-		expectedResponse *Response
-		expectedError    string
+		name           string
+		ctx            context.Context
+		assetID        int
+		statusCode     int
+		respBody       string
+		expectedOutput string
+		expectedError  string
 	}{
 		{
 			name:          "NilContext",
-			mockResponses: []MockResponse{},
-			s: &RepoService{
-				client: c,
-				owner:  "octocat",
-				repo:   "Hello-World",
-			},
 			ctx:           nil,
-			params:        params,
+			assetID:       1,
 			expectedError: `net/http: nil Context`,
 		},
 		{
-			name: "InvalidStatusCode",
-			mockResponses: []MockResponse{
-				{"POST", "/repos/octocat/Hello-World/releases", 401, http.Header{}, `{
-					"message": "Bad credentials"
-				}`},
-			},
-			s: &RepoService{
-				client: c,
-				owner:  "octocat",
-				repo:   "Hello-World",
-			},
-			ctx:           context.Background(),
-			params:        params,
-			expectedError: `POST /repos/octocat/Hello-World/releases: 401 Bad credentials`,
-		},
-		{
-			name: "ّInvalidResponse",
-			mockResponses: []MockResponse{
-				{"POST", "/repos/octocat/Hello-World/releases", 201, http.Header{}, `{`},
-			},
-			s: &RepoService{
-				client: c,
-				owner:  "octocat",
-				repo:   "Hello-World",
-			},
+			name:          "InvalidStatusCode",
 			ctx:           context.Background(),
-			params:        params,
-			expectedError: `unexpected EOF`,
+			assetID:       1,
+			statusCode:    401,
+			respBody:      `{"message": "Bad credentials"}`,
+			expectedError: `GET /repos/octocat/Hello-World/releases/assets/1: 401 Bad credentials`,
 		},
 		{
-			name: "Success",
-			mockResponses: []MockResponse{
-				{"POST", "/repos/octocat/Hello-World/releases", 201, header, releaseBody},
-			},
-			s: &RepoService{
-				client: c,
-				owner:  "octocat",
-				repo:   "Hello-World",
-			},
-			ctx:             context.Background(),
-			params:          params,
-			expectedRelease: &release,
-			expectedResponse: &Response{
-				Rate: expectedRate,
-			},
+			name:           "Redirect",
+			ctx:            context.Background(),
+			assetID:        1,
+			statusCode:     302,
+			expectedOutput: "content",
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			ts := newHTTPTestServer(tc.mockResponses...)
-			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "GET", r.Method)
+				assert.Equal(t, "/repos/octocat/Hello-World/releases/assets/1", r.URL.Path)
+				assert.Equal(t, "application/octet-stream", r.Header.Get("Accept"))
+				assert.Equal(t, "token github-token", r.Header.Get("Authorization"))
 
-			release, resp, err := tc.s.CreateRelease(tc.ctx, tc.params)
+				for k, vals := range header {
+					w.Header()[k] = vals
+				}
+				if tc.statusCode == 302 {
+					w.Header().Set("Location", storageURL.String()+"/github-production-release-asset/1")
+				}
+				w.WriteHeader(tc.statusCode)
+				_, _ = io.WriteString(w, tc.respBody)
+			}))
+			defer ts.Close()
+
+			c := &Client{
+				httpClient:  &http.Client{},
+				rates:       map[rateGroup]Rate{},
+				accessToken: "github-token",
+			}
+			c.apiURL, _ = url.Parse(ts.URL)
+
+			s := &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			}
+
+			buf := new(bytes.Buffer)
+			resp, err := s.DownloadReleaseAssetByID(tc.ctx, tc.assetID, buf)
 
 			if tc.expectedError != "" {
-				assert.Nil(t, release)
 				assert.Nil(t, resp)
 				assert.EqualError(t, err, tc.expectedError)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, tc.expectedRelease, release)
+				assert.Equal(t, tc.expectedOutput, buf.String())
 				assert.NotNil(t, resp)
 				assert.NotNil(t, resp.Response)
-				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
 			}
 		})
 	}
 }
 
-func TestRepoService_UpdateRelease(t *testing.T) {
+func TestRepoService_Deployments(t *testing.T) {
 	c := &Client{
 		httpClient: &http.Client{},
 		rates:      map[rateGroup]Rate{},
 		apiURL:     publicAPIURL,
 	}
 
-	params := ReleaseParams{
-		Name:       "v1.0.0",
-		TagName:    "v1.0.0",
-		Target:     "main",
-		Draft:      false,
-		Prerelease: false,
-		Body:       "Description of the release",
-	}
-
 	tests := []struct {
-		name             string
-		mockResponses    []MockResponse
-		s                *RepoService
-		ctx              context.Context
-		releaseID        int
-		params           ReleaseParams
-		expectedRelease  *Release
-		expectedResponse *Response
-		expectedError    string
+		name                string
+		mockResponses       []MockResponse
+		s                   *RepoService
+		ctx                 context.Context
+		pageSize            int
+		pageNo              int
+		expectedDeployments []Deployment
+		expectedResponse    *Response
+		expectedError       string
 	}{
 		{
 			name:          "NilContext",
@@ -2181,14 +11033,14 @@ func TestRepoService_UpdateRelease(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           nil,
-			releaseID:     1,
-			params:        params,
+			pageSize:      10,
+			pageNo:        1,
 			expectedError: `net/http: nil Context`,
 		},
 		{
 			name: "InvalidStatusCode",
 			mockResponses: []MockResponse{
-				{"PATCH", "/repos/octocat/Hello-World/releases/1", 401, http.Header{}, `{
+				{"GET", "/repos/octocat/Hello-World/deployments", 401, http.Header{}, `{
 					"message": "Bad credentials"
 				}`},
 			},
@@ -2198,14 +11050,14 @@ func TestRepoService_UpdateRelease(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           context.Background(),
-			releaseID:     1,
-			params:        params,
-			expectedError: `PATCH /repos/octocat/Hello-World/releases/1: 401 Bad credentials`,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /repos/octocat/Hello-World/deployments: 401 Bad credentials`,
 		},
 		{
-			name: "ّInvalidResponse",
+			name: "InvalidResponse",
 			mockResponses: []MockResponse{
-				{"PATCH", "/repos/octocat/Hello-World/releases/1", 200, http.Header{}, `{`},
+				{"GET", "/repos/octocat/Hello-World/deployments", 200, http.Header{}, `[`},
 			},
 			s: &RepoService{
 				client: c,
@@ -2213,26 +11065,27 @@ func TestRepoService_UpdateRelease(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           context.Background(),
-			releaseID:     1,
-			params:        params,
+			pageSize:      10,
+			pageNo:        1,
 			expectedError: `unexpected EOF`,
 		},
 		{
 			name: "Success",
 			mockResponses: []MockResponse{
-				{"PATCH", "/repos/octocat/Hello-World/releases/1", 200, header, releaseBody},
+				{"GET", "/repos/octocat/Hello-World/deployments", 200, header, deploymentsBody},
 			},
 			s: &RepoService{
 				client: c,
 				owner:  "octocat",
 				repo:   "Hello-World",
 			},
-			ctx:             context.Background(),
-			releaseID:       1,
-			params:          params,
-			expectedRelease: &release,
+			ctx:                 context.Background(),
+			pageSize:            10,
+			pageNo:              1,
+			expectedDeployments: []Deployment{deployment},
 			expectedResponse: &Response{
-				Rate: expectedRate,
+				Pages: expectedPages,
+				Rate:  expectedRate,
 			},
 		},
 	}
@@ -2242,44 +11095,80 @@ func TestRepoService_UpdateRelease(t *testing.T) {
 			ts := newHTTPTestServer(tc.mockResponses...)
 			tc.s.client.apiURL, _ = url.Parse(ts.URL)
 
-			release, resp, err := tc.s.UpdateRelease(tc.ctx, tc.releaseID, tc.params)
+			deployments, resp, err := tc.s.Deployments(tc.ctx, tc.pageSize, tc.pageNo)
 
 			if tc.expectedError != "" {
-				assert.Nil(t, release)
+				assert.Nil(t, deployments)
 				assert.Nil(t, resp)
 				assert.EqualError(t, err, tc.expectedError)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, tc.expectedRelease, release)
+				assert.Equal(t, tc.expectedDeployments, deployments)
 				assert.NotNil(t, resp)
 				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
 				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
 			}
 		})
 	}
 }
 
-func TestRepoService_UploadReleaseAsset(t *testing.T) {
+func TestDeploymentParams_Validate(t *testing.T) {
+	tests := []struct {
+		name          string
+		p             DeploymentParams
+		expectedError string
+	}{
+		{
+			name:          "MissingRef",
+			p:             DeploymentParams{Environment: "production"},
+			expectedError: `invalid deployment params: ref is required`,
+		},
+		{
+			name: "OK",
+			p:    DeploymentParams{Ref: "topic-branch"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.p.Validate()
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRepoService_CreateDeployment(t *testing.T) {
 	c := &Client{
 		httpClient: &http.Client{},
 		rates:      map[rateGroup]Rate{},
-		uploadURL:  publicUploadURL,
+		apiURL:     publicAPIURL,
+	}
+
+	params := DeploymentParams{
+		Ref:         "topic-branch",
+		Task:        "deploy",
+		Environment: "production",
+		Description: "Deploy request from hubot",
 	}
 
 	tests := []struct {
-		name                 string
-		mockResponses        []MockResponse
-		s                    *RepoService
-		ctx                  context.Context
-		releaseID            int
-		assetFile            string
-		assetLabel           string
-		expectedReleaseAsset *ReleaseAsset
-		expectedResponse     *Response
-		expectedError        string
+		name               string
+		mockResponses      []MockResponse
+		s                  *RepoService
+		ctx                context.Context
+		params             DeploymentParams
+		expectedDeployment *Deployment
+		expectedResponse   *Response
+		expectedError      string
 	}{
 		{
-			name:          "NilContext",
+			name:          "InvalidParams",
 			mockResponses: []MockResponse{},
 			s: &RepoService{
 				client: c,
@@ -2287,43 +11176,25 @@ func TestRepoService_UploadReleaseAsset(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           nil,
-			releaseID:     1,
-			assetFile:     "repo_test.go",
-			assetLabel:    "test",
-			expectedError: `net/http: nil Context`,
-		},
-		{
-			name:          "NoFile",
-			mockResponses: []MockResponse{},
-			s: &RepoService{
-				client: c,
-				owner:  "octocat",
-				repo:   "Hello-World",
-			},
-			ctx:           context.Background(),
-			releaseID:     1,
-			assetFile:     "unknown",
-			assetLabel:    "test",
-			expectedError: `open unknown: no such file or directory`,
+			params:        DeploymentParams{},
+			expectedError: `invalid deployment params: ref is required`,
 		},
 		{
-			name:          "BadFile",
+			name:          "NilContext",
 			mockResponses: []MockResponse{},
 			s: &RepoService{
 				client: c,
 				owner:  "octocat",
 				repo:   "Hello-World",
 			},
-			ctx:           context.Background(),
-			releaseID:     1,
-			assetFile:     "/dev/null",
-			assetLabel:    "test",
-			expectedError: `EOF`,
+			ctx:           nil,
+			params:        params,
+			expectedError: `net/http: nil Context`,
 		},
 		{
 			name: "InvalidStatusCode",
 			mockResponses: []MockResponse{
-				{"POST", "/repos/octocat/Hello-World/releases/1/assets", 401, http.Header{}, `{
+				{"POST", "/repos/octocat/Hello-World/deployments", 401, http.Header{}, `{
 					"message": "Bad credentials"
 				}`},
 			},
@@ -2333,15 +11204,13 @@ func TestRepoService_UploadReleaseAsset(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           context.Background(),
-			releaseID:     1,
-			assetFile:     "repo_test.go",
-			assetLabel:    "test",
-			expectedError: `POST /repos/octocat/Hello-World/releases/1/assets: 401 Bad credentials`,
+			params:        params,
+			expectedError: `POST /repos/octocat/Hello-World/deployments: 401 Bad credentials`,
 		},
 		{
-			name: "ّInvalidResponse",
+			name: "InvalidResponse",
 			mockResponses: []MockResponse{
-				{"POST", "/repos/octocat/Hello-World/releases/1/assets", 201, http.Header{}, `{`},
+				{"POST", "/repos/octocat/Hello-World/deployments", 201, http.Header{}, `{`},
 			},
 			s: &RepoService{
 				client: c,
@@ -2349,26 +11218,22 @@ func TestRepoService_UploadReleaseAsset(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           context.Background(),
-			releaseID:     1,
-			assetFile:     "repo_test.go",
-			assetLabel:    "test",
+			params:        params,
 			expectedError: `unexpected EOF`,
 		},
 		{
 			name: "Success",
 			mockResponses: []MockResponse{
-				{"POST", "/repos/octocat/Hello-World/releases/1/assets", 201, header, releaseAssetBody},
+				{"POST", "/repos/octocat/Hello-World/deployments", 201, header, deploymentBody},
 			},
 			s: &RepoService{
 				client: c,
 				owner:  "octocat",
 				repo:   "Hello-World",
 			},
-			ctx:                  context.Background(),
-			releaseID:            1,
-			assetFile:            "repo_test.go",
-			assetLabel:           "test",
-			expectedReleaseAsset: &releaseAsset,
+			ctx:                context.Background(),
+			params:             params,
+			expectedDeployment: &deployment,
 			expectedResponse: &Response{
 				Rate: expectedRate,
 			},
@@ -2378,17 +11243,17 @@ func TestRepoService_UploadReleaseAsset(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			ts := newHTTPTestServer(tc.mockResponses...)
-			tc.s.client.uploadURL, _ = url.Parse(ts.URL)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
 
-			asset, resp, err := tc.s.UploadReleaseAsset(tc.ctx, tc.releaseID, tc.assetFile, tc.assetLabel)
+			deployment, resp, err := tc.s.CreateDeployment(tc.ctx, tc.params)
 
 			if tc.expectedError != "" {
-				assert.Nil(t, asset)
+				assert.Nil(t, deployment)
 				assert.Nil(t, resp)
 				assert.EqualError(t, err, tc.expectedError)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, tc.expectedReleaseAsset, asset)
+				assert.Equal(t, tc.expectedDeployment, deployment)
 				assert.NotNil(t, resp)
 				assert.NotNil(t, resp.Response)
 				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
@@ -2397,89 +11262,504 @@ func TestRepoService_UploadReleaseAsset(t *testing.T) {
 	}
 }
 
-func TestRepoService_DownloadReleaseAsset(t *testing.T) {
-	c := &Client{
-		httpClient:  &http.Client{},
-		rates:       map[rateGroup]Rate{},
-		downloadURL: publicDownloadURL,
+func TestDeploymentStatus_Validate(t *testing.T) {
+	tests := []struct {
+		name          string
+		s             DeploymentStatus
+		expectedError string
+	}{
+		{
+			name:          "MissingState",
+			s:             DeploymentStatus{Environment: "production"},
+			expectedError: `invalid deployment status: state is required`,
+		},
+		{
+			name:          "UnknownState",
+			s:             DeploymentStatus{State: "done"},
+			expectedError: `invalid deployment status: unknown state "done"`,
+		},
+		{
+			name: "InProgress",
+			s:    DeploymentStatus{State: "in_progress"},
+		},
+		{
+			name: "OK",
+			s:    DeploymentStatus{State: "success"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.s.Validate()
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRepoService_CreateDeploymentStatus(t *testing.T) {
+	status := DeploymentStatus{
+		State:       "success",
+		Description: "Deployment finished successfully.",
+		Environment: "production",
+		TargetURL:   "https://example.com/deployment/42/output",
+		LogURL:      "https://example.com/deployment/42/output",
 	}
 
 	tests := []struct {
-		name             string
-		mockResponses    []MockResponse
-		s                *RepoService
-		ctx              context.Context
-		releaseTag       string
-		assetName        string
-		w                io.Writer
-		expectedResponse *Response
-		expectedError    string
+		name                     string
+		statusCode               int
+		respBody                 string
+		ctx                      context.Context
+		deploymentID             int
+		status                   DeploymentStatus
+		accept                   string
+		expectedAccept           string
+		expectedDeploymentStatus *DeploymentStatus
+		expectedError            string
 	}{
 		{
-			name:          "NilContext",
-			mockResponses: []MockResponse{},
-			s: &RepoService{
+			name:          "InvalidParams",
+			ctx:           nil,
+			deploymentID:  1,
+			status:        DeploymentStatus{},
+			expectedError: `invalid deployment status: state is required`,
+		},
+		{
+			name:           "NilContext",
+			ctx:            nil,
+			deploymentID:   1,
+			status:         status,
+			expectedAccept: "application/vnd.github.flash-preview+json, application/vnd.github.ant-man-preview+json",
+			expectedError:  `net/http: nil Context`,
+		},
+		{
+			name:           "InvalidStatusCode",
+			statusCode:     401,
+			respBody:       `{"message": "Bad credentials"}`,
+			ctx:            context.Background(),
+			deploymentID:   1,
+			status:         status,
+			expectedAccept: "application/vnd.github.flash-preview+json, application/vnd.github.ant-man-preview+json",
+			expectedError:  `POST /repos/octocat/Hello-World/deployments/1/statuses: 401 Bad credentials`,
+		},
+		{
+			name:           "InvalidResponse",
+			statusCode:     201,
+			respBody:       `{`,
+			ctx:            context.Background(),
+			deploymentID:   1,
+			status:         status,
+			expectedAccept: "application/vnd.github.flash-preview+json, application/vnd.github.ant-man-preview+json",
+			expectedError:  `unexpected EOF`,
+		},
+		{
+			name:       "Success",
+			statusCode: 201,
+			respBody: `{
+				"id": 1,
+				"state": "success",
+				"description": "Deployment finished successfully.",
+				"environment": "production",
+				"target_url": "https://example.com/deployment/42/output",
+				"log_url": "https://example.com/deployment/42/output",
+				"created_at": "2020-10-20T20:00:00Z"
+			}`,
+			ctx:            context.Background(),
+			deploymentID:   1,
+			status:         status,
+			expectedAccept: "application/vnd.github.flash-preview+json, application/vnd.github.ant-man-preview+json",
+			expectedDeploymentStatus: &DeploymentStatus{
+				ID:          1,
+				State:       "success",
+				Description: "Deployment finished successfully.",
+				Environment: "production",
+				TargetURL:   "https://example.com/deployment/42/output",
+				LogURL:      "https://example.com/deployment/42/output",
+				CreatedAt:   parseGitHubTime("2020-10-20T20:00:00Z"),
+			},
+		},
+		{
+			name:       "AcceptOverride",
+			statusCode: 201,
+			respBody: `{
+				"id": 1,
+				"state": "success",
+				"description": "Deployment finished successfully.",
+				"environment": "production",
+				"target_url": "https://example.com/deployment/42/output",
+				"log_url": "https://example.com/deployment/42/output",
+				"created_at": "2020-10-20T20:00:00Z"
+			}`,
+			ctx:            context.Background(),
+			deploymentID:   1,
+			status:         status,
+			accept:         "application/vnd.github.v3+json",
+			expectedAccept: "application/vnd.github.v3+json",
+			expectedDeploymentStatus: &DeploymentStatus{
+				ID:          1,
+				State:       "success",
+				Description: "Deployment finished successfully.",
+				Environment: "production",
+				TargetURL:   "https://example.com/deployment/42/output",
+				LogURL:      "https://example.com/deployment/42/output",
+				CreatedAt:   parseGitHubTime("2020-10-20T20:00:00Z"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var body map[string]string
+				assert.Equal(t, "POST", r.Method)
+				assert.Equal(t, "/repos/octocat/Hello-World/deployments/1/statuses", r.URL.Path)
+				assert.Equal(t, tc.expectedAccept, r.Header.Get("Accept"))
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, map[string]string{
+					"state":       "success",
+					"description": "Deployment finished successfully.",
+					"environment": "production",
+					"target_url":  "https://example.com/deployment/42/output",
+					"log_url":     "https://example.com/deployment/42/output",
+				}, body)
+
+				for k, vals := range header {
+					w.Header()[k] = vals
+				}
+				w.WriteHeader(tc.statusCode)
+				_, _ = io.WriteString(w, tc.respBody)
+			}))
+			defer ts.Close()
+
+			c := &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+			}
+			c.apiURL, _ = url.Parse(ts.URL)
+
+			s := &RepoService{
 				client: c,
 				owner:  "octocat",
 				repo:   "Hello-World",
+			}
+
+			var deploymentStatus *DeploymentStatus
+			var resp *Response
+			var err error
+
+			if tc.accept == "" {
+				deploymentStatus, resp, err = s.CreateDeploymentStatus(tc.ctx, tc.deploymentID, tc.status)
+			} else {
+				deploymentStatus, resp, err = s.CreateDeploymentStatusWithMedia(tc.ctx, tc.deploymentID, tc.status, tc.accept)
+			}
+
+			if tc.expectedError != "" {
+				assert.Nil(t, deploymentStatus)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedDeploymentStatus, deploymentStatus)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, expectedRate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_TrafficViews(t *testing.T) {
+	trafficBody := `{
+		"count": 14,
+		"uniques": 3,
+		"views": [
+			{
+				"timestamp": "2020-10-10T00:00:00Z",
+				"count": 8,
+				"uniques": 2
 			},
+			{
+				"timestamp": "2020-10-11T00:00:00Z",
+				"count": 6,
+				"uniques": 1
+			}
+		]
+	}`
+
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		per             string
+		statusCode      int
+		respBody        string
+		expectedQuery   string
+		expectedTraffic *Traffic
+		expectedError   string
+	}{
+		{
+			name:          "NilContext",
 			ctx:           nil,
-			releaseTag:    "v1.0.0",
-			assetName:     "example.zip",
-			w:             nil,
+			per:           "day",
 			expectedError: `net/http: nil Context`,
 		},
 		{
-			name: "InvalidStatusCode",
-			mockResponses: []MockResponse{
-				{"GET", "/octocat/Hello-World/releases/download/v1.0.0/example.zip", 401, http.Header{}, ``},
+			name:          "InvalidStatusCode",
+			ctx:           context.Background(),
+			per:           "day",
+			statusCode:    401,
+			respBody:      `{"message": "Bad credentials"}`,
+			expectedQuery: "per=day",
+			expectedError: `GET /repos/octocat/Hello-World/traffic/views: 401 Bad credentials`,
+		},
+		{
+			name:          "InvalidResponse",
+			ctx:           context.Background(),
+			per:           "day",
+			statusCode:    200,
+			respBody:      `{`,
+			expectedQuery: "per=day",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name:          "Success",
+			ctx:           context.Background(),
+			per:           "",
+			statusCode:    200,
+			respBody:      trafficBody,
+			expectedQuery: "",
+			expectedTraffic: &Traffic{
+				Count:   14,
+				Uniques: 3,
+				Views: []TrafficData{
+					{
+						Timestamp: parseGitHubTime("2020-10-10T00:00:00Z"),
+						Count:     8,
+						Uniques:   2,
+					},
+					{
+						Timestamp: parseGitHubTime("2020-10-11T00:00:00Z"),
+						Count:     6,
+						Uniques:   1,
+					},
+				},
 			},
-			s: &RepoService{
+		},
+		{
+			name:          "SuccessPerWeek",
+			ctx:           context.Background(),
+			per:           "week",
+			statusCode:    200,
+			respBody:      trafficBody,
+			expectedQuery: "per=week",
+			expectedTraffic: &Traffic{
+				Count:   14,
+				Uniques: 3,
+				Views: []TrafficData{
+					{
+						Timestamp: parseGitHubTime("2020-10-10T00:00:00Z"),
+						Count:     8,
+						Uniques:   2,
+					},
+					{
+						Timestamp: parseGitHubTime("2020-10-11T00:00:00Z"),
+						Count:     6,
+						Uniques:   1,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "GET", r.Method)
+				assert.Equal(t, "/repos/octocat/Hello-World/traffic/views", r.URL.Path)
+				assert.Equal(t, tc.expectedQuery, r.URL.RawQuery)
+
+				for k, vals := range header {
+					w.Header()[k] = vals
+				}
+				w.WriteHeader(tc.statusCode)
+				_, _ = io.WriteString(w, tc.respBody)
+			}))
+			defer ts.Close()
+
+			c := &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+			}
+			c.apiURL, _ = url.Parse(ts.URL)
+
+			s := &RepoService{
 				client: c,
 				owner:  "octocat",
 				repo:   "Hello-World",
+			}
+
+			traffic, resp, err := s.TrafficViews(tc.ctx, tc.per)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, traffic)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedTraffic, traffic)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, expectedRate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_TrafficClones(t *testing.T) {
+	trafficBody := `{
+		"count": 14,
+		"uniques": 3,
+		"clones": [
+			{
+				"timestamp": "2020-10-10T00:00:00Z",
+				"count": 8,
+				"uniques": 2
 			},
+			{
+				"timestamp": "2020-10-11T00:00:00Z",
+				"count": 6,
+				"uniques": 1
+			}
+		]
+	}`
+
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		per             string
+		statusCode      int
+		respBody        string
+		expectedQuery   string
+		expectedTraffic *Traffic
+		expectedError   string
+	}{
+		{
+			name:          "NilContext",
+			ctx:           nil,
+			per:           "day",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name:          "InvalidStatusCode",
 			ctx:           context.Background(),
-			releaseTag:    "v1.0.0",
-			assetName:     "example.zip",
-			w:             ioutil.Discard,
-			expectedError: `GET /octocat/Hello-World/releases/download/v1.0.0/example.zip: 401 `,
+			per:           "day",
+			statusCode:    401,
+			respBody:      `{"message": "Bad credentials"}`,
+			expectedQuery: "per=day",
+			expectedError: `GET /repos/octocat/Hello-World/traffic/clones: 401 Bad credentials`,
 		},
 		{
-			name: "Success",
-			mockResponses: []MockResponse{
-				{"GET", "/octocat/Hello-World/releases/download/v1.0.0/example.zip", 200, header, `content`},
-			},
-			s: &RepoService{
-				client: c,
-				owner:  "octocat",
-				repo:   "Hello-World",
+			name:          "InvalidResponse",
+			ctx:           context.Background(),
+			per:           "day",
+			statusCode:    200,
+			respBody:      `{`,
+			expectedQuery: "per=day",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name:          "Success",
+			ctx:           context.Background(),
+			per:           "",
+			statusCode:    200,
+			respBody:      trafficBody,
+			expectedQuery: "",
+			expectedTraffic: &Traffic{
+				Count:   14,
+				Uniques: 3,
+				Clones: []TrafficData{
+					{
+						Timestamp: parseGitHubTime("2020-10-10T00:00:00Z"),
+						Count:     8,
+						Uniques:   2,
+					},
+					{
+						Timestamp: parseGitHubTime("2020-10-11T00:00:00Z"),
+						Count:     6,
+						Uniques:   1,
+					},
+				},
 			},
-			ctx:        context.Background(),
-			releaseTag: "v1.0.0",
-			assetName:  "example.zip",
-			w:          ioutil.Discard,
-			expectedResponse: &Response{
-				Rate: expectedRate,
+		},
+		{
+			name:          "SuccessPerWeek",
+			ctx:           context.Background(),
+			per:           "week",
+			statusCode:    200,
+			respBody:      trafficBody,
+			expectedQuery: "per=week",
+			expectedTraffic: &Traffic{
+				Count:   14,
+				Uniques: 3,
+				Clones: []TrafficData{
+					{
+						Timestamp: parseGitHubTime("2020-10-10T00:00:00Z"),
+						Count:     8,
+						Uniques:   2,
+					},
+					{
+						Timestamp: parseGitHubTime("2020-10-11T00:00:00Z"),
+						Count:     6,
+						Uniques:   1,
+					},
+				},
 			},
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			ts := newHTTPTestServer(tc.mockResponses...)
-			tc.s.client.downloadURL, _ = url.Parse(ts.URL)
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "GET", r.Method)
+				assert.Equal(t, "/repos/octocat/Hello-World/traffic/clones", r.URL.Path)
+				assert.Equal(t, tc.expectedQuery, r.URL.RawQuery)
 
-			resp, err := tc.s.DownloadReleaseAsset(tc.ctx, tc.releaseTag, tc.assetName, tc.w)
+				for k, vals := range header {
+					w.Header()[k] = vals
+				}
+				w.WriteHeader(tc.statusCode)
+				_, _ = io.WriteString(w, tc.respBody)
+			}))
+			defer ts.Close()
+
+			c := &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+			}
+			c.apiURL, _ = url.Parse(ts.URL)
+
+			s := &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			}
+
+			traffic, resp, err := s.TrafficClones(tc.ctx, tc.per)
 
 			if tc.expectedError != "" {
+				assert.Nil(t, traffic)
 				assert.Nil(t, resp)
 				assert.EqualError(t, err, tc.expectedError)
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedTraffic, traffic)
 				assert.NotNil(t, resp)
 				assert.NotNil(t, resp.Response)
-				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+				assert.Equal(t, expectedRate, resp.Rate)
 			}
 		})
 	}
@@ -0,0 +1,141 @@
+package github
+
+import (
+	"context"
+	"net/http"
+)
+
+// SearchService provides GitHub APIs for searching.
+// Search requests have their own rate limit, separate from the core rate limit.
+// See https://docs.github.com/en/rest/reference/search
+type SearchService struct {
+	client *Client
+}
+
+// RepoSearchResult is the result of a repository search.
+type RepoSearchResult struct {
+	TotalCount        int          `json:"total_count"`
+	IncompleteResults bool         `json:"incomplete_results"`
+	Items             []Repository `json:"items"`
+}
+
+// Repositories searches repositories by a query page by page.
+// The query can include any of the qualifiers supported by GitHub (e.g. language:go stars:>100).
+// See https://docs.github.com/rest/reference/search#search-repositories
+func (s *SearchService) Repositories(ctx context.Context, query string, pageSize, pageNo int) (*RepoSearchResult, *Response, error) {
+	req, err := s.newSearchRequest(ctx, "/search/repositories", query, pageSize, pageNo)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(RepoSearchResult)
+
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result, resp, nil
+}
+
+// IssueSearchResult is the result of an issue search.
+type IssueSearchResult struct {
+	TotalCount        int     `json:"total_count"`
+	IncompleteResults bool    `json:"incomplete_results"`
+	Items             []Issue `json:"items"`
+}
+
+// Issues searches issues and pull requests by a query page by page.
+// The query is passed through as is, so it can include any of the qualifiers supported by GitHub (e.g. repo:octocat/Hello-World is:open label:bug).
+// See https://docs.github.com/rest/reference/search#search-issues-and-pull-requests
+func (s *SearchService) Issues(ctx context.Context, query string, pageSize, pageNo int) (*IssueSearchResult, *Response, error) {
+	req, err := s.newSearchRequest(ctx, "/search/issues", query, pageSize, pageNo)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(IssueSearchResult)
+
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result, resp, nil
+}
+
+// UserSearchResult is the result of a user search.
+type UserSearchResult struct {
+	TotalCount        int    `json:"total_count"`
+	IncompleteResults bool   `json:"incomplete_results"`
+	Items             []User `json:"items"`
+}
+
+// Users searches users by a query page by page.
+// The query can include any of the qualifiers supported by GitHub (e.g. type:org location:canada).
+// See https://docs.github.com/rest/reference/search#search-users
+func (s *SearchService) Users(ctx context.Context, query string, pageSize, pageNo int) (*UserSearchResult, *Response, error) {
+	req, err := s.newSearchRequest(ctx, "/search/users", query, pageSize, pageNo)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(UserSearchResult)
+
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result, resp, nil
+}
+
+// CodeResult is a file matching a code search.
+type CodeResult struct {
+	Name       string     `json:"name"`
+	Path       string     `json:"path"`
+	SHA        string     `json:"sha"`
+	Repository Repository `json:"repository"`
+	HTMLURL    string     `json:"html_url"`
+}
+
+// CodeSearchResult is the result of a code search.
+type CodeSearchResult struct {
+	TotalCount        int          `json:"total_count"`
+	IncompleteResults bool         `json:"incomplete_results"`
+	Items             []CodeResult `json:"items"`
+}
+
+// Code searches files by a query page by page.
+// The query can include any of the qualifiers supported by GitHub (e.g. addClass in:file language:js repo:jquery/jquery).
+// Code search is only available to authenticated clients.
+// Searching the code in private repositories requires the access token to have the repo scope (see EnsureScopes).
+// See https://docs.github.com/rest/reference/search#search-code
+func (s *SearchService) Code(ctx context.Context, query string, pageSize, pageNo int) (*CodeSearchResult, *Response, error) {
+	req, err := s.newSearchRequest(ctx, "/search/code", query, pageSize, pageNo)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(CodeSearchResult)
+
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result, resp, nil
+}
+
+func (s *SearchService) newSearchRequest(ctx context.Context, url, query string, pageSize, pageNo int) (*http.Request, error) {
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	q := req.URL.Query()
+	q.Add("q", query)
+	req.URL.RawQuery = q.Encode()
+
+	return req, nil
+}
@@ -0,0 +1,538 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	repoSearchBody = `{
+		"total_count": 1,
+		"incomplete_results": false,
+		"items": [
+			{
+				"id": 1296269,
+				"name": "Hello-World",
+				"full_name": "octocat/Hello-World",
+				"owner": {
+					"login": "octocat",
+					"id": 1,
+					"type": "User"
+				}
+			}
+		]
+	}`
+
+	userSearchBody = `{
+		"total_count": 1,
+		"incomplete_results": false,
+		"items": [
+			{
+				"login": "octocat",
+				"id": 1,
+				"type": "User",
+				"url": "https://api.github.com/users/octocat",
+				"html_url": "https://github.com/octocat"
+			}
+		]
+	}`
+
+	codeSearchBody = `{
+		"total_count": 1,
+		"incomplete_results": false,
+		"items": [
+			{
+				"name": "README.md",
+				"path": "docs/README.md",
+				"sha": "3d21ec53a331a6f037a91c368710b99387d012c1",
+				"html_url": "https://github.com/octocat/Hello-World/blob/main/docs/README.md",
+				"repository": {
+					"id": 1296269,
+					"name": "Hello-World",
+					"full_name": "octocat/Hello-World",
+					"owner": {
+						"login": "octocat",
+						"id": 1,
+						"type": "User"
+					}
+				}
+			}
+		]
+	}`
+)
+
+func TestSearchService_Repositories(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *SearchService
+		ctx              context.Context
+		query            string
+		pageSize         int
+		pageNo           int
+		expectedResult   *RepoSearchResult
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &SearchService{
+				client: c,
+			},
+			ctx:           nil,
+			query:         "language:go stars:>100",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/search/repositories", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &SearchService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			query:         "language:go stars:>100",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /search/repositories: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/search/repositories", 200, http.Header{}, `{`},
+			},
+			s: &SearchService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			query:         "language:go stars:>100",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/search/repositories", 200, header, repoSearchBody},
+			},
+			s: &SearchService{
+				client: c,
+			},
+			ctx:      context.Background(),
+			query:    "language:go stars:>100",
+			pageSize: 10,
+			pageNo:   1,
+			expectedResult: &RepoSearchResult{
+				TotalCount:        1,
+				IncompleteResults: false,
+				Items: []Repository{
+					{
+						ID:       1296269,
+						Name:     "Hello-World",
+						FullName: "octocat/Hello-World",
+						Owner: User{
+							ID:    1,
+							Login: "octocat",
+							Type:  "User",
+						},
+					},
+				},
+			},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			result, resp, err := tc.s.Repositories(tc.ctx, tc.query, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, result)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, result)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+				assert.Equal(t, tc.expectedResponse.Rate, tc.s.client.rates[rateGroupSearch])
+			}
+		})
+	}
+}
+
+func TestSearchService_Issues(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *SearchService
+		ctx              context.Context
+		query            string
+		pageSize         int
+		pageNo           int
+		expectedResult   *IssueSearchResult
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &SearchService{
+				client: c,
+			},
+			ctx:           nil,
+			query:         "repo:octocat/Hello-World is:closed",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/search/issues", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &SearchService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			query:         "repo:octocat/Hello-World is:closed",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /search/issues: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/search/issues", 200, http.Header{}, `{`},
+			},
+			s: &SearchService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			query:         "repo:octocat/Hello-World is:closed",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/search/issues", 200, header, `{"total_count": 2, "incomplete_results": false, "items": ` + issuesBody + `}`},
+			},
+			s: &SearchService{
+				client: c,
+			},
+			ctx:      context.Background(),
+			query:    "repo:octocat/Hello-World is:closed",
+			pageSize: 10,
+			pageNo:   1,
+			expectedResult: &IssueSearchResult{
+				TotalCount:        2,
+				IncompleteResults: false,
+				Items:             []Issue{issue2, issue1},
+			},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			result, resp, err := tc.s.Issues(tc.ctx, tc.query, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, result)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, result)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+				assert.Equal(t, tc.expectedResponse.Rate, tc.s.client.rates[rateGroupSearch])
+			}
+		})
+	}
+}
+
+func TestSearchService_Users(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *SearchService
+		ctx              context.Context
+		query            string
+		pageSize         int
+		pageNo           int
+		expectedResult   *UserSearchResult
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &SearchService{
+				client: c,
+			},
+			ctx:           nil,
+			query:         "type:user octocat",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/search/users", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &SearchService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			query:         "type:user octocat",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /search/users: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/search/users", 200, http.Header{}, `{`},
+			},
+			s: &SearchService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			query:         "type:user octocat",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/search/users", 200, header, userSearchBody},
+			},
+			s: &SearchService{
+				client: c,
+			},
+			ctx:      context.Background(),
+			query:    "type:user octocat",
+			pageSize: 10,
+			pageNo:   1,
+			expectedResult: &UserSearchResult{
+				TotalCount:        1,
+				IncompleteResults: false,
+				Items: []User{
+					{
+						ID:      1,
+						Login:   "octocat",
+						Type:    "User",
+						URL:     "https://api.github.com/users/octocat",
+						HTMLURL: "https://github.com/octocat",
+					},
+				},
+			},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			result, resp, err := tc.s.Users(tc.ctx, tc.query, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, result)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, result)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+				assert.Equal(t, tc.expectedResponse.Rate, tc.s.client.rates[rateGroupSearch])
+			}
+		})
+	}
+}
+
+func TestSearchService_Code(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *SearchService
+		ctx              context.Context
+		query            string
+		pageSize         int
+		pageNo           int
+		expectedResult   *CodeSearchResult
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &SearchService{
+				client: c,
+			},
+			ctx:           nil,
+			query:         "filename:README.md repo:octocat/Hello-World",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/search/code", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &SearchService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			query:         "filename:README.md repo:octocat/Hello-World",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /search/code: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/search/code", 200, http.Header{}, `{`},
+			},
+			s: &SearchService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			query:         "filename:README.md repo:octocat/Hello-World",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/search/code", 200, header, codeSearchBody},
+			},
+			s: &SearchService{
+				client: c,
+			},
+			ctx:      context.Background(),
+			query:    "filename:README.md repo:octocat/Hello-World",
+			pageSize: 10,
+			pageNo:   1,
+			expectedResult: &CodeSearchResult{
+				TotalCount:        1,
+				IncompleteResults: false,
+				Items: []CodeResult{
+					{
+						Name:    "README.md",
+						Path:    "docs/README.md",
+						SHA:     "3d21ec53a331a6f037a91c368710b99387d012c1",
+						HTMLURL: "https://github.com/octocat/Hello-World/blob/main/docs/README.md",
+						Repository: Repository{
+							ID:       1296269,
+							Name:     "Hello-World",
+							FullName: "octocat/Hello-World",
+							Owner: User{
+								ID:    1,
+								Login: "octocat",
+								Type:  "User",
+							},
+						},
+					},
+				},
+			},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			result, resp, err := tc.s.Code(tc.ctx, tc.query, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, result)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, result)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+				assert.Equal(t, tc.expectedResponse.Rate, tc.s.client.rates[rateGroupSearch])
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"io"
 	"time"
 )
 
@@ -19,6 +20,10 @@ type User struct {
 	Type       string    `json:"type"`
 	Email      string    `json:"email"`
 	Name       string    `json:"name"`
+	Company    string    `json:"company"`
+	Blog       string    `json:"blog"`
+	Location   string    `json:"location"`
+	Bio        string    `json:"bio"`
 	URL        string    `json:"url"`
 	HTMLURL    string    `json:"html_url"`
 	OrgsURL    string    `json:"organizations_url"`
@@ -28,6 +33,32 @@ type User struct {
 	UpdatedAt  time.Time `json:"updated_at"`
 }
 
+// PublicKey is a GitHub public SSH key object.
+type PublicKey struct {
+	ID        int       `json:"id"`
+	Key       string    `json:"key"`
+	Title     string    `json:"title"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type (
+	// GPGKeyEmail is an email address associated with a GPG key.
+	GPGKeyEmail struct {
+		Email    string `json:"email"`
+		Verified bool   `json:"verified"`
+	}
+
+	// GPGKey is a GitHub GPG key object.
+	GPGKey struct {
+		ID        int           `json:"id"`
+		KeyID     string        `json:"key_id"`
+		PublicKey string        `json:"public_key"`
+		Emails    []GPGKeyEmail `json:"emails"`
+		CanSign   bool          `json:"can_sign"`
+		CreatedAt time.Time     `json:"created_at"`
+	}
+)
+
 // User returns the authenticated user.
 // If the access token does not have the user scope, then the response includes only the public information.
 // If the access token has the user scope, then the response includes the public and private information.
@@ -48,6 +79,35 @@ func (s *UsersService) User(ctx context.Context) (*User, *Response, error) {
 	return user, resp, nil
 }
 
+// UserUpdateParams is used for updating the authenticated user.
+// Empty fields are not sent, so they are left unchanged.
+type UserUpdateParams struct {
+	Name     string `json:"name,omitempty"`
+	Email    string `json:"email,omitempty"`
+	Blog     string `json:"blog,omitempty"`
+	Company  string `json:"company,omitempty"`
+	Location string `json:"location,omitempty"`
+	Bio      string `json:"bio,omitempty"`
+}
+
+// Update updates the profile of the authenticated user.
+// See https://docs.github.com/rest/reference/users#update-the-authenticated-user
+func (s *UsersService) Update(ctx context.Context, params UserUpdateParams) (*User, *Response, error) {
+	req, err := s.client.NewRequest(ctx, "PATCH", "/user", params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user := new(User)
+
+	resp, err := s.client.Do(req, user)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return user, resp, nil
+}
+
 // Get retrieves a user by its username (login).
 // See https://docs.github.com/rest/reference/users#get-a-user
 func (s *UsersService) Get(ctx context.Context, username string) (*User, *Response, error) {
@@ -66,3 +126,158 @@ func (s *UsersService) Get(ctx context.Context, username string) (*User, *Respon
 
 	return user, resp, nil
 }
+
+// PublicKeysText streams the public SSH keys of a given user to w in the authorized_keys format.
+// The keys are fetched from the plaintext keys endpoint on the download host (https://github.com/{username}.keys).
+// For a user with no public keys, nothing is written to w.
+func (s *UsersService) PublicKeysText(ctx context.Context, username string, w io.Writer) (*Response, error) {
+	url := fmt.Sprintf("/%s.keys", username)
+	req, err := s.client.NewDownloadRequest(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, w)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Keys retrieves the verified public SSH keys of a given user page by page.
+// See https://docs.github.com/rest/reference/users#list-public-keys-for-a-user
+func (s *UsersService) Keys(ctx context.Context, username string, pageSize, pageNo int) ([]PublicKey, *Response, error) {
+	url := fmt.Sprintf("/users/%s/keys", username)
+	return s.keys(ctx, url, pageSize, pageNo)
+}
+
+// AuthenticatedKeys retrieves the public SSH keys of the authenticated user page by page.
+// The access token should have the read:public_key scope (see EnsureScopes).
+// See https://docs.github.com/rest/reference/users#list-public-ssh-keys-for-the-authenticated-user
+func (s *UsersService) AuthenticatedKeys(ctx context.Context, pageSize, pageNo int) ([]PublicKey, *Response, error) {
+	return s.keys(ctx, "/user/keys", pageSize, pageNo)
+}
+
+func (s *UsersService) keys(ctx context.Context, url string, pageSize, pageNo int) ([]PublicKey, *Response, error) {
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keys := []PublicKey{}
+
+	resp, err := s.client.Do(req, &keys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return keys, resp, nil
+}
+
+// CreateKey adds a public SSH key to the authenticated user.
+// The access token should have the write:public_key or admin:public_key scope (see EnsureScopes).
+// See https://docs.github.com/rest/reference/users#create-a-public-ssh-key-for-the-authenticated-user
+func (s *UsersService) CreateKey(ctx context.Context, title, key string) (*PublicKey, *Response, error) {
+	body := struct {
+		Title string `json:"title,omitempty"`
+		Key   string `json:"key"`
+	}{
+		Title: title,
+		Key:   key,
+	}
+
+	req, err := s.client.NewRequest(ctx, "POST", "/user/keys", body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	publicKey := new(PublicKey)
+
+	resp, err := s.client.Do(req, publicKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return publicKey, resp, nil
+}
+
+// DeleteKey removes a public SSH key from the authenticated user.
+// The access token should have the admin:public_key scope (see EnsureScopes).
+// See https://docs.github.com/rest/reference/users#delete-a-public-ssh-key-for-the-authenticated-user
+func (s *UsersService) DeleteKey(ctx context.Context, keyID int) (*Response, error) {
+	url := fmt.Sprintf("/user/keys/%d", keyID)
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GPGKeys retrieves the GPG keys of the authenticated user page by page.
+// The access token should have the read:gpg_key scope (see EnsureScopes).
+// See https://docs.github.com/rest/reference/users#list-gpg-keys-for-the-authenticated-user
+func (s *UsersService) GPGKeys(ctx context.Context, pageSize, pageNo int) ([]GPGKey, *Response, error) {
+	req, err := s.client.NewPageRequest(ctx, "GET", "/user/gpg_keys", pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keys := []GPGKey{}
+
+	resp, err := s.client.Do(req, &keys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return keys, resp, nil
+}
+
+// CreateGPGKey adds a GPG key in ASCII-armored format to the authenticated user.
+// The access token should have the write:gpg_key scope (see EnsureScopes).
+// See https://docs.github.com/rest/reference/users#create-a-gpg-key-for-the-authenticated-user
+func (s *UsersService) CreateGPGKey(ctx context.Context, armoredKey string) (*GPGKey, *Response, error) {
+	body := struct {
+		ArmoredPublicKey string `json:"armored_public_key"`
+	}{
+		ArmoredPublicKey: armoredKey,
+	}
+
+	req, err := s.client.NewRequest(ctx, "POST", "/user/gpg_keys", body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key := new(GPGKey)
+
+	resp, err := s.client.Do(req, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return key, resp, nil
+}
+
+// DeleteGPGKey removes a GPG key from the authenticated user.
+// The access token should have the admin:gpg_key scope (see EnsureScopes).
+// See https://docs.github.com/rest/reference/users#delete-a-gpg-key-for-the-authenticated-user
+func (s *UsersService) DeleteGPGKey(ctx context.Context, keyID int) (*Response, error) {
+	url := fmt.Sprintf("/user/gpg_keys/%d", keyID)
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
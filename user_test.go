@@ -1,8 +1,12 @@
 package github
 
 import (
+	"bytes"
 	"context"
+	"io"
+	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
 
@@ -20,6 +24,54 @@ const (
 		"name": "The Octocat",
 		"email": "octocat@github.com"
 	}`
+
+	publicKeyBody = `{
+		"id": 2,
+		"key": "ssh-rsa AAAB3NzaC1yc2EAAAADAQABAAABAQCy",
+		"title": "ci-agent",
+		"created_at": "2020-10-20T20:00:00Z"
+	}`
+
+	publicKeysBody = `[
+		{
+			"id": 1,
+			"key": "ssh-rsa AAAB3NzaC1yc2EAAAADAQABAAABAQCx"
+		},
+		{
+			"id": 2,
+			"key": "ssh-rsa AAAB3NzaC1yc2EAAAADAQABAAABAQCy"
+		}
+	]`
+
+	gpgKeyBody = `{
+		"id": 3,
+		"key_id": "3262EFF25BA0D270",
+		"public_key": "xsBNBFayYZ...",
+		"emails": [
+			{
+				"email": "octocat@users.noreply.github.com",
+				"verified": true
+			}
+		],
+		"can_sign": true,
+		"created_at": "2020-10-20T20:00:00Z"
+	}`
+
+	gpgKeysBody = `[
+		{
+			"id": 3,
+			"key_id": "3262EFF25BA0D270",
+			"public_key": "xsBNBFayYZ...",
+			"emails": [
+				{
+					"email": "octocat@users.noreply.github.com",
+					"verified": true
+				}
+			],
+			"can_sign": true,
+			"created_at": "2020-10-20T20:00:00Z"
+		}
+	]`
 )
 
 var (
@@ -32,6 +84,30 @@ var (
 		URL:     "https://api.github.com/users/octocat",
 		HTMLURL: "https://github.com/octocat",
 	}
+
+	publicKey1 = PublicKey{
+		ID:  1,
+		Key: "ssh-rsa AAAB3NzaC1yc2EAAAADAQABAAABAQCx",
+	}
+
+	publicKey2 = PublicKey{
+		ID:  2,
+		Key: "ssh-rsa AAAB3NzaC1yc2EAAAADAQABAAABAQCy",
+	}
+
+	gpgKey = GPGKey{
+		ID:        3,
+		KeyID:     "3262EFF25BA0D270",
+		PublicKey: "xsBNBFayYZ...",
+		Emails: []GPGKeyEmail{
+			{
+				Email:    "octocat@users.noreply.github.com",
+				Verified: true,
+			},
+		},
+		CanSign:   true,
+		CreatedAt: parseGitHubTime("2020-10-20T20:00:00Z"),
+	}
 )
 
 func TestUserService_User(t *testing.T) {
@@ -123,6 +199,125 @@ func TestUserService_User(t *testing.T) {
 	}
 }
 
+func TestUserService_Update(t *testing.T) {
+	updatedUserBody := `{
+		"login": "octocat",
+		"id": 1,
+		"url": "https://api.github.com/users/octocat",
+		"html_url": "https://github.com/octocat",
+		"type": "User",
+		"name": "The Octocat",
+		"email": "octocat@github.com",
+		"blog": "https://github.blog",
+		"bio": "There once was..."
+	}`
+
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		params        UserUpdateParams
+		statusCode    int
+		respBody      string
+		expectedBody  string
+		expectedUser  *User
+		expectedError string
+	}{
+		{
+			name:          "NilContext",
+			ctx:           nil,
+			params:        UserUpdateParams{},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			ctx:  context.Background(),
+			params: UserUpdateParams{
+				Bio: "There once was...",
+			},
+			statusCode:    401,
+			respBody:      `{"message": "Bad credentials"}`,
+			expectedBody:  `{"bio": "There once was..."}`,
+			expectedError: `PATCH /user: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			ctx:  context.Background(),
+			params: UserUpdateParams{
+				Bio: "There once was...",
+			},
+			statusCode:    200,
+			respBody:      `{`,
+			expectedBody:  `{"bio": "There once was..."}`,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "PartialUpdate",
+			ctx:  context.Background(),
+			params: UserUpdateParams{
+				Blog: "https://github.blog",
+				Bio:  "There once was...",
+			},
+			statusCode:   200,
+			respBody:     updatedUserBody,
+			expectedBody: `{"blog": "https://github.blog", "bio": "There once was..."}`,
+			expectedUser: &User{
+				ID:      1,
+				Login:   "octocat",
+				Type:    "User",
+				Email:   "octocat@github.com",
+				Name:    "The Octocat",
+				Blog:    "https://github.blog",
+				Bio:     "There once was...",
+				URL:     "https://api.github.com/users/octocat",
+				HTMLURL: "https://github.com/octocat",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "PATCH", r.Method)
+				assert.Equal(t, "/user", r.URL.Path)
+
+				b, _ := ioutil.ReadAll(r.Body)
+				assert.JSONEq(t, tc.expectedBody, string(b))
+
+				for k, vals := range header {
+					w.Header()[k] = vals
+				}
+				w.WriteHeader(tc.statusCode)
+				_, _ = io.WriteString(w, tc.respBody)
+			}))
+			defer ts.Close()
+
+			c := &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+			}
+			c.apiURL, _ = url.Parse(ts.URL)
+
+			s := &UsersService{
+				client: c,
+			}
+
+			user, resp, err := s.Update(tc.ctx, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, user)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedUser, user)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, expectedRate, resp.Rate)
+			}
+		})
+	}
+}
+
 func TestUserService_Get(t *testing.T) {
 	c := &Client{
 		httpClient: &http.Client{},
@@ -216,3 +411,744 @@ func TestUserService_Get(t *testing.T) {
 		})
 	}
 }
+
+func TestUserService_PublicKeysText(t *testing.T) {
+	c := &Client{
+		httpClient:  &http.Client{},
+		rates:       map[rateGroup]Rate{},
+		downloadURL: publicDownloadURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *UsersService
+		ctx              context.Context
+		username         string
+		expectedKeys     string
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &UsersService{
+				client: c,
+			},
+			ctx:           nil,
+			username:      "octocat",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/octocat.keys", 404, http.Header{}, ``},
+			},
+			s: &UsersService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			username:      "octocat",
+			expectedError: `GET /octocat.keys: 404 `,
+		},
+		{
+			name: "Success_NoKeys",
+			mockResponses: []MockResponse{
+				{"GET", "/octocat.keys", 200, header, ``},
+			},
+			s: &UsersService{
+				client: c,
+			},
+			ctx:          context.Background(),
+			username:     "octocat",
+			expectedKeys: "",
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/octocat.keys", 200, header, "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOctocat\nssh-rsa AAAAB3NzaC1yc2EAAAADAQABOctocat\n"},
+			},
+			s: &UsersService{
+				client: c,
+			},
+			ctx:          context.Background(),
+			username:     "octocat",
+			expectedKeys: "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOctocat\nssh-rsa AAAAB3NzaC1yc2EAAAADAQABOctocat\n",
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.downloadURL, _ = url.Parse(ts.URL)
+
+			w := new(bytes.Buffer)
+			resp, err := tc.s.PublicKeysText(tc.ctx, tc.username, w)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedKeys, w.String())
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestUserService_Keys(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *UsersService
+		ctx              context.Context
+		username         string
+		pageSize         int
+		pageNo           int
+		expectedKeys     []PublicKey
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &UsersService{
+				client: c,
+			},
+			ctx:           nil,
+			username:      "octocat",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/users/octocat/keys", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &UsersService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			username:      "octocat",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /users/octocat/keys: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/users/octocat/keys", 200, http.Header{}, `[`},
+			},
+			s: &UsersService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			username:      "octocat",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/users/octocat/keys", 200, header, publicKeysBody},
+			},
+			s: &UsersService{
+				client: c,
+			},
+			ctx:          context.Background(),
+			username:     "octocat",
+			pageSize:     10,
+			pageNo:       1,
+			expectedKeys: []PublicKey{publicKey1, publicKey2},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			keys, resp, err := tc.s.Keys(tc.ctx, tc.username, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, keys)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedKeys, keys)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestUserService_AuthenticatedKeys(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *UsersService
+		ctx              context.Context
+		pageSize         int
+		pageNo           int
+		expectedKeys     []PublicKey
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &UsersService{
+				client: c,
+			},
+			ctx:           nil,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/user/keys", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &UsersService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /user/keys: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/user/keys", 200, http.Header{}, `[`},
+			},
+			s: &UsersService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/user/keys", 200, header, publicKeysBody},
+			},
+			s: &UsersService{
+				client: c,
+			},
+			ctx:          context.Background(),
+			pageSize:     10,
+			pageNo:       1,
+			expectedKeys: []PublicKey{publicKey1, publicKey2},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			keys, resp, err := tc.s.AuthenticatedKeys(tc.ctx, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, keys)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedKeys, keys)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestUserService_CreateKey(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name              string
+		mockResponses     []MockResponse
+		s                 *UsersService
+		ctx               context.Context
+		title             string
+		key               string
+		expectedPublicKey *PublicKey
+		expectedResponse  *Response
+		expectedError     string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &UsersService{
+				client: c,
+			},
+			ctx:           nil,
+			title:         "ci-agent",
+			key:           "ssh-rsa AAAB3NzaC1yc2EAAAADAQABAAABAQCy",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/user/keys", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &UsersService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			title:         "ci-agent",
+			key:           "ssh-rsa AAAB3NzaC1yc2EAAAADAQABAAABAQCy",
+			expectedError: `POST /user/keys: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/user/keys", 201, http.Header{}, `{`},
+			},
+			s: &UsersService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			title:         "ci-agent",
+			key:           "ssh-rsa AAAB3NzaC1yc2EAAAADAQABAAABAQCy",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/user/keys", 201, header, publicKeyBody},
+			},
+			s: &UsersService{
+				client: c,
+			},
+			ctx:   context.Background(),
+			title: "ci-agent",
+			key:   "ssh-rsa AAAB3NzaC1yc2EAAAADAQABAAABAQCy",
+			expectedPublicKey: &PublicKey{
+				ID:        2,
+				Key:       "ssh-rsa AAAB3NzaC1yc2EAAAADAQABAAABAQCy",
+				Title:     "ci-agent",
+				CreatedAt: parseGitHubTime("2020-10-20T20:00:00Z"),
+			},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			publicKey, resp, err := tc.s.CreateKey(tc.ctx, tc.title, tc.key)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, publicKey)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedPublicKey, publicKey)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestUserService_DeleteKey(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *UsersService
+		ctx              context.Context
+		keyID            int
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &UsersService{
+				client: c,
+			},
+			ctx:           nil,
+			keyID:         2,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/user/keys/2", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &UsersService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			keyID:         2,
+			expectedError: `DELETE /user/keys/2: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/user/keys/2", 204, header, ``},
+			},
+			s: &UsersService{
+				client: c,
+			},
+			ctx:   context.Background(),
+			keyID: 2,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.DeleteKey(tc.ctx, tc.keyID)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestUserService_GPGKeys(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *UsersService
+		ctx              context.Context
+		pageSize         int
+		pageNo           int
+		expectedKeys     []GPGKey
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &UsersService{
+				client: c,
+			},
+			ctx:           nil,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/user/gpg_keys", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &UsersService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /user/gpg_keys: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/user/gpg_keys", 200, http.Header{}, `[`},
+			},
+			s: &UsersService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/user/gpg_keys", 200, header, gpgKeysBody},
+			},
+			s: &UsersService{
+				client: c,
+			},
+			ctx:          context.Background(),
+			pageSize:     10,
+			pageNo:       1,
+			expectedKeys: []GPGKey{gpgKey},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			keys, resp, err := tc.s.GPGKeys(tc.ctx, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, keys)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedKeys, keys)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestUserService_CreateGPGKey(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *UsersService
+		ctx              context.Context
+		armoredKey       string
+		expectedKey      *GPGKey
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &UsersService{
+				client: c,
+			},
+			ctx:           nil,
+			armoredKey:    "-----BEGIN PGP PUBLIC KEY BLOCK-----",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/user/gpg_keys", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &UsersService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			armoredKey:    "-----BEGIN PGP PUBLIC KEY BLOCK-----",
+			expectedError: `POST /user/gpg_keys: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/user/gpg_keys", 201, http.Header{}, `{`},
+			},
+			s: &UsersService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			armoredKey:    "-----BEGIN PGP PUBLIC KEY BLOCK-----",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/user/gpg_keys", 201, header, gpgKeyBody},
+			},
+			s: &UsersService{
+				client: c,
+			},
+			ctx:         context.Background(),
+			armoredKey:  "-----BEGIN PGP PUBLIC KEY BLOCK-----",
+			expectedKey: &gpgKey,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			key, resp, err := tc.s.CreateGPGKey(tc.ctx, tc.armoredKey)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, key)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedKey, key)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestUserService_DeleteGPGKey(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *UsersService
+		ctx              context.Context
+		keyID            int
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &UsersService{
+				client: c,
+			},
+			ctx:           nil,
+			keyID:         3,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/user/gpg_keys/3", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &UsersService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			keyID:         3,
+			expectedError: `DELETE /user/gpg_keys/3: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/user/gpg_keys/3", 204, header, ``},
+			},
+			s: &UsersService{
+				client: c,
+			},
+			ctx:   context.Background(),
+			keyID: 3,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.DeleteGPGKey(tc.ctx, tc.keyID)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}